@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748352619485 implements MigrationInterface {
+  name = 'Migration1748352619485'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "entrypoint" jsonb`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "cmd" jsonb`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "cmd"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "entrypoint"`)
+  }
+}
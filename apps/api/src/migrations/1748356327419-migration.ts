@@ -0,0 +1,18 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748356327419 implements MigrationInterface {
+  name = 'Migration1748356327419'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "buildNoCache" boolean NOT NULL DEFAULT false`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "buildNoCache"`)
+  }
+}
@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748358131254 implements MigrationInterface {
+  name = 'Migration1748358131254'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "dns" jsonb`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "extraHosts" jsonb`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "extraHosts"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "dns"`)
+  }
+}
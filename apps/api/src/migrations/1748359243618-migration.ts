@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748359243618 implements MigrationInterface {
+  name = 'Migration1748359243618'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "readonlyRootfs" boolean NOT NULL DEFAULT false`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "tmpfs" jsonb`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "tmpfs"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "readonlyRootfs"`)
+  }
+}
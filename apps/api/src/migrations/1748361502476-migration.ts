@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748361502476 implements MigrationInterface {
+  name = 'Migration1748361502476'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "privileged" boolean`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "mountDockerSocket" boolean NOT NULL DEFAULT false`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "mountDockerSocket"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "privileged"`)
+  }
+}
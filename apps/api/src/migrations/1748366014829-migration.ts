@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748366014829 implements MigrationInterface {
+  name = 'Migration1748366014829'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "hostname" character varying`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "domainname" character varying`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "domainname"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "hostname"`)
+  }
+}
@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748368231906 implements MigrationInterface {
+  name = 'Migration1748368231906'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "postCreateCommands" jsonb`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "postCreateContinueOnError" boolean NOT NULL DEFAULT false`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "postCreateContinueOnError"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "postCreateCommands"`)
+  }
+}
@@ -0,0 +1,20 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class Migration1748369342715 implements MigrationInterface {
+  name = 'Migration1748369342715'
+
+  public async up(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "stopGracePeriodSeconds" integer`)
+    await queryRunner.query(`ALTER TABLE "workspace" ADD "stopTimeout" integer`)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<void> {
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "stopTimeout"`)
+    await queryRunner.query(`ALTER TABLE "workspace" DROP COLUMN "stopGracePeriodSeconds"`)
+  }
+}
@@ -10,6 +10,7 @@ export const WorkspaceEvents = {
   CREATED: 'workspace.created',
   STARTED: 'workspace.started',
   STOPPED: 'workspace.stopped',
+  REBUILT: 'workspace.rebuilt',
   DESTROYED: 'workspace.destroyed',
   RESIZED: 'workspace.resized',
   PUBLIC_STATUS_UPDATED: 'workspace.public-status.updated',
@@ -22,6 +22,7 @@ import {
   RawBodyRequest,
   Next,
   ParseBoolPipe,
+  ParseIntPipe,
 } from '@nestjs/common'
 import { IncomingMessage, ServerResponse } from 'http'
 import { NextFunction } from 'express'
@@ -267,6 +268,12 @@ export class ImageController {
     type: Boolean,
     description: 'Whether to follow the logs stream',
   })
+  @ApiQuery({
+    name: 'offset',
+    required: false,
+    type: Number,
+    description: 'Byte offset to resume the logs stream from',
+  })
   @UseGuards(ImageAccessGuard)
   async getImageBuildLogs(
     @Request() req: RawBodyRequest<IncomingMessage>,
@@ -274,6 +281,7 @@ export class ImageController {
     @Next() next: NextFunction,
     @Param('id') imageId: string,
     @Query('follow', new ParseBoolPipe({ optional: true })) follow?: boolean,
+    @Query('offset', new ParseIntPipe({ optional: true })) offset?: number,
   ): Promise<void> {
     let image = await this.imageService.getImage(imageId)
 
@@ -299,7 +307,7 @@ export class ImageController {
       throw new NotFoundException(`Build node for image ${imageId} not found`)
     }
 
-    const logProxy = new LogProxy(node.apiUrl, image.id, node.apiKey, follow === true, req, res, next)
+    const logProxy = new LogProxy(node.apiUrl, image.id, node.apiKey, follow === true, req, res, next, offset)
     return logProxy.create()
   }
 }
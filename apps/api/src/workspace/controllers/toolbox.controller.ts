@@ -45,6 +45,8 @@ import {
   GitCommitRequestDto,
   GitCommitResponseDto,
   GitRepoRequestDto,
+  GitResetRequestDto,
+  GitResetResponseDto,
   GitStatusDto,
   ListBranchResponseDto,
   GitCommitInfoDto,
@@ -698,6 +700,35 @@ export class ToolboxController {
     return await this.toolboxProxy(req, res, next)
   }
 
+  @Post(':workspaceId/toolbox/git/reset')
+  @HttpCode(200)
+  @UseInterceptors(ContentTypeInterceptor)
+  @ApiOperation({
+    summary: 'Reset repository',
+    description: 'Fetch the remote and reset the current branch to its upstream branch',
+    operationId: 'gitResetRepository',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Repository reset successfully',
+    type: GitResetResponseDto,
+  })
+  @ApiResponse({
+    status: 409,
+    description: 'The repository has uncommitted changes and discard_changes is not set',
+  })
+  @ApiBody({
+    type: GitResetRequestDto,
+  })
+  @ApiParam({ name: 'workspaceId', type: String, required: true })
+  async gitResetRepository(
+    @Request() req: RawBodyRequest<IncomingMessage>,
+    @Res() res: ServerResponse<IncomingMessage>,
+    @Next() next: NextFunction,
+  ): Promise<void> {
+    return await this.toolboxProxy(req, res, next)
+  }
+
   @Get(':workspaceId/toolbox/git/status')
   @ApiOperation({
     summary: 'Get git status',
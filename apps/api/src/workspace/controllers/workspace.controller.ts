@@ -23,6 +23,7 @@ import {
   RawBodyRequest,
   Next,
   ParseBoolPipe,
+  ParseIntPipe,
 } from '@nestjs/common'
 import Redis from 'ioredis'
 import { CombinedAuthGuard } from '../../auth/combined-auth.guard'
@@ -58,6 +59,7 @@ import { PortPreviewUrlDto } from '../dto/port-preview-url.dto'
 import { IncomingMessage, ServerResponse } from 'http'
 import { NextFunction } from 'http-proxy-middleware/dist/types'
 import { LogProxy } from '../proxy/log-proxy'
+import { EventsProxy } from '../proxy/events-proxy'
 
 @ApiTags('workspace')
 @Controller('workspace')
@@ -175,7 +177,6 @@ export class WorkspaceController {
   @UseGuards(WorkspaceAccessGuard)
   async getWorkspace(
     @Workspace() workspace: WorkspaceEntity,
-    // eslint-disable-next-line @typescript-eslint/no-unused-vars
     @Query('verbose') verbose?: boolean,
   ): Promise<WorkspaceDto> {
     let node: Node
@@ -183,7 +184,21 @@ export class WorkspaceController {
       node = await this.nodeService.findOne(workspace.nodeId)
     }
 
-    return WorkspaceDto.fromWorkspace(workspace, node?.domain)
+    const dto = WorkspaceDto.fromWorkspace(workspace, node?.domain)
+
+    // Query params arrive as strings, the runtime details are only fetched from the node when requested
+    if (node && String(verbose) === 'true') {
+      try {
+        const info = await this.nodeService.getWorkspaceInfo(node, workspace.id)
+        dto.info.imageDigest = info.imageDigest
+        dto.info.restartCount = info.restartCount
+        dto.info.phaseDurations = info.phaseDurations
+      } catch (error) {
+        this.logger.warn(`Failed to get verbose info of workspace ${workspace.id}: ${error.message}`)
+      }
+    }
+
+    return dto
   }
 
   @Delete(':workspaceId')
@@ -247,6 +262,13 @@ export class WorkspaceController {
     description: 'ID of the workspace',
     type: 'string',
   })
+  @ApiQuery({
+    name: 'timeout',
+    required: false,
+    type: Number,
+    description:
+      'Seconds the workspace gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the workspace. 0 kills it immediately',
+  })
   @ApiResponse({
     status: 200,
     description: 'Workspace has been stopped',
@@ -254,8 +276,41 @@ export class WorkspaceController {
   @Throttle({ default: { limit: 100 } })
   @RequiredOrganizationResourcePermissions([OrganizationResourcePermission.WRITE_SANDBOXES])
   @UseGuards(WorkspaceAccessGuard)
-  async stopWorkspace(@Param('workspaceId') workspaceId: string): Promise<void> {
-    return this.workspaceService.stop(workspaceId)
+  async stopWorkspace(
+    @Param('workspaceId') workspaceId: string,
+    @Query('timeout', new ParseIntPipe({ optional: true })) timeout?: number,
+  ): Promise<void> {
+    return this.workspaceService.stop(workspaceId, timeout)
+  }
+
+  @Post(':workspaceId/rebuild')
+  @HttpCode(200)
+  @ApiOperation({
+    summary: 'Rebuild workspace',
+    operationId: 'rebuildWorkspace',
+  })
+  @ApiParam({
+    name: 'workspaceId',
+    description: 'ID of the workspace',
+    type: 'string',
+  })
+  @ApiQuery({
+    name: 'noCache',
+    required: false,
+    type: Boolean,
+    description: 'Rebuild the image without the build cache',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Workspace is being rebuilt',
+  })
+  @RequiredOrganizationResourcePermissions([OrganizationResourcePermission.WRITE_SANDBOXES])
+  @UseGuards(WorkspaceAccessGuard)
+  async rebuildWorkspace(
+    @Param('workspaceId') workspaceId: string,
+    @Query('noCache', new ParseBoolPipe({ optional: true })) noCache?: boolean,
+  ): Promise<void> {
+    return this.workspaceService.rebuild(workspaceId, noCache === true)
   }
 
   @Put(':workspaceId/labels')
@@ -402,6 +457,49 @@ export class WorkspaceController {
     return this.workspaceService.getPortPreviewUrl(workspaceId, port)
   }
 
+  @Get(':workspaceId/events')
+  @ApiOperation({
+    summary: 'Stream workspace events',
+    description: 'Streams events of the workspace container like OOM kills and restarts, one JSON object per line',
+    operationId: 'getWorkspaceEvents',
+  })
+  @ApiParam({
+    name: 'workspaceId',
+    description: 'ID of the workspace',
+    type: 'string',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Workspace events stream',
+  })
+  @ApiQuery({
+    name: 'since',
+    required: false,
+    type: String,
+    description: 'Also return the events of this duration before now, e.g. 1h',
+  })
+  @UseGuards(WorkspaceAccessGuard)
+  async getWorkspaceEvents(
+    @Request() req: RawBodyRequest<IncomingMessage>,
+    @Res() res: ServerResponse<IncomingMessage>,
+    @Next() next: NextFunction,
+    @Param('workspaceId') workspaceId: string,
+    @Query('since') since?: string,
+  ): Promise<void> {
+    const workspace = await this.workspaceService.findOne(workspaceId)
+    if (!workspace || !workspace.nodeId) {
+      throw new NotFoundException(`Workspace with ID ${workspaceId} not found or has no node assigned`)
+    }
+
+    const node = await this.nodeService.findOne(workspace.nodeId)
+    if (!node) {
+      throw new NotFoundException(`Node for workspace ${workspaceId} not found`)
+    }
+
+    const eventsProxy = new EventsProxy(node.apiUrl, workspace.id, node.apiKey, since, req, res, next)
+    return eventsProxy.create()
+  }
+
   @Get(':workspaceId/build-logs')
   @ApiOperation({
     summary: 'Get build logs',
@@ -422,6 +520,12 @@ export class WorkspaceController {
     type: Boolean,
     description: 'Whether to follow the logs stream',
   })
+  @ApiQuery({
+    name: 'offset',
+    required: false,
+    type: Number,
+    description: 'Byte offset to resume the logs stream from',
+  })
   @UseGuards(WorkspaceAccessGuard)
   async getBuildLogs(
     @Request() req: RawBodyRequest<IncomingMessage>,
@@ -429,6 +533,7 @@ export class WorkspaceController {
     @Next() next: NextFunction,
     @Param('workspaceId') workspaceId: string,
     @Query('follow', new ParseBoolPipe({ optional: true })) follow?: boolean,
+    @Query('offset', new ParseIntPipe({ optional: true })) offset?: number,
   ): Promise<void> {
     const workspace = await this.workspaceService.findOne(workspaceId)
     if (!workspace || !workspace.nodeId) {
@@ -452,6 +557,7 @@ export class WorkspaceController {
       req,
       res,
       next,
+      offset,
     )
     return logProxy.create()
   }
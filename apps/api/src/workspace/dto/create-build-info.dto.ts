@@ -4,7 +4,7 @@
  */
 
 import { ApiProperty, ApiPropertyOptional, ApiSchema } from '@nestjs/swagger'
-import { IsNotEmpty, IsOptional, IsString } from 'class-validator'
+import { IsBoolean, IsNotEmpty, IsOptional, IsString } from 'class-validator'
 
 @ApiSchema({ name: 'CreateBuildInfo' })
 export class CreateBuildInfoDto {
@@ -24,4 +24,12 @@ export class CreateBuildInfoDto {
   @IsString({ each: true })
   @IsOptional()
   contextHashes?: string[]
+
+  @ApiPropertyOptional({
+    description: 'Rebuild the image even if it was built before, without using the build cache',
+    example: false,
+  })
+  @IsBoolean()
+  @IsOptional()
+  noCache?: boolean
 }
@@ -3,13 +3,203 @@
  * SPDX-License-Identifier: AGPL-3.0
  */
 
-import { IsEnum, IsObject, IsOptional, IsString, IsNumber, IsBoolean } from 'class-validator'
-import { ApiPropertyOptional, ApiSchema } from '@nestjs/swagger'
+import {
+  IsArray,
+  IsEnum,
+  IsObject,
+  IsOptional,
+  IsString,
+  IsNumber,
+  IsBoolean,
+  Max,
+  Min,
+  ValidateNested,
+  IsNotEmpty,
+  IsBase64,
+  Matches,
+} from 'class-validator'
+import { Type } from 'class-transformer'
+import { ApiProperty, ApiPropertyOptional, ApiSchema } from '@nestjs/swagger'
 import { WorkspaceClass } from '../enums/workspace-class.enum'
 import { NodeRegion } from '../enums/node-region.enum'
+import { ImagePullPolicy } from '../enums/image-pull-policy.enum'
 import { WorkspaceVolume } from './workspace.dto'
 import { CreateBuildInfoDto } from './create-build-info.dto'
 
+@ApiSchema({ name: 'ReadinessProbe' })
+export class ReadinessProbeDto {
+  @ApiPropertyOptional({
+    description: 'TCP port inside the workspace that must accept connections',
+    example: 3000,
+    type: 'integer',
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(1)
+  @Max(65535)
+  port?: number
+
+  @ApiPropertyOptional({
+    description: 'Command run inside the workspace that must exit with code 0',
+    type: [String],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  command?: string[]
+
+  @ApiPropertyOptional({
+    description: 'Number of attempts before the probe fails, defaults to 30',
+    type: 'integer',
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  retries?: number
+
+  @ApiPropertyOptional({
+    description: 'Delay between attempts in seconds, defaults to 1',
+    type: 'integer',
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  intervalSeconds?: number
+
+  @ApiPropertyOptional({
+    description: 'Timeout of a single attempt in seconds, defaults to 1',
+    type: 'integer',
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  timeoutSeconds?: number
+}
+
+@ApiSchema({ name: 'WorkspaceSecurity' })
+export class WorkspaceSecurityDto {
+  @ApiPropertyOptional({
+    description: 'Path of a seccomp profile on the node, or unconfined',
+    example: 'unconfined',
+  })
+  @IsOptional()
+  @IsString()
+  seccompProfile?: string
+
+  @ApiPropertyOptional({
+    description: 'Name of a loaded AppArmor profile, or unconfined',
+    example: 'unconfined',
+  })
+  @IsOptional()
+  @IsString()
+  appArmorProfile?: string
+
+  @ApiPropertyOptional({
+    description: 'Linux capabilities to add, e.g. SYS_PTRACE',
+    type: [String],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  capAdd?: string[]
+
+  @ApiPropertyOptional({
+    description: 'Linux capabilities to drop, e.g. NET_RAW',
+    type: [String],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  capDrop?: string[]
+}
+
+@ApiSchema({ name: 'WorkspaceUlimit' })
+export class WorkspaceUlimitDto {
+  @ApiProperty({
+    description: 'Name of the limit, e.g. nofile or nproc',
+    example: 'nofile',
+  })
+  @IsString()
+  @IsNotEmpty()
+  name: string
+
+  @ApiProperty({
+    description: 'Soft limit',
+    example: 65536,
+    type: 'integer',
+  })
+  @IsNumber()
+  @Min(0)
+  soft: number
+
+  @ApiProperty({
+    description: 'Hard limit',
+    example: 1048576,
+    type: 'integer',
+  })
+  @IsNumber()
+  @Min(0)
+  hard: number
+}
+
+@ApiSchema({ name: 'WorkspaceInitStep' })
+export class WorkspaceInitStepDto {
+  @ApiProperty({
+    description: 'Image of the step',
+    example: 'alpine:3.21',
+  })
+  @IsString()
+  @IsNotEmpty()
+  image: string
+
+  @ApiPropertyOptional({
+    description: 'Entrypoint of the step, overrides the entrypoint of the image',
+    type: [String],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  entrypoint?: string[]
+
+  @ApiPropertyOptional({
+    description: 'Command of the step, overrides the command of the image',
+    type: [String],
+    example: ['sh', '-c', 'echo seeded > /data/seed'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  command?: string[]
+}
+
+@ApiSchema({ name: 'WorkspaceFile' })
+export class WorkspaceFileDto {
+  @ApiProperty({
+    description: 'Absolute path of the file inside the workspace',
+    example: '/home/daytona/.config/app.json',
+  })
+  @IsString()
+  @IsNotEmpty()
+  path: string
+
+  @ApiProperty({
+    description: 'Base64 encoded content of the file',
+  })
+  @IsBase64()
+  content: string
+
+  @ApiPropertyOptional({
+    description: 'Permission bits of the file as a decimal number (e.g. 384 for 0600), defaults to 0644',
+    example: 420,
+    type: 'integer',
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  @Max(4095)
+  mode?: number
+}
+
 @ApiSchema({ name: 'CreateWorkspace' })
 export class CreateWorkspaceDto {
   @ApiPropertyOptional({
@@ -134,4 +324,201 @@ export class CreateWorkspaceDto {
   @IsOptional()
   @IsObject()
   buildInfo?: CreateBuildInfoDto
+
+  @ApiPropertyOptional({
+    description: 'Create the workspace without starting it, it stays stopped until it is started',
+    example: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  noStart?: boolean
+
+  @ApiPropertyOptional({
+    description: 'Entrypoint of the workspace container, overrides the entrypoint of the image',
+    type: [String],
+    example: ['/bin/sh', '-c'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  entrypoint?: string[]
+
+  @ApiPropertyOptional({
+    description:
+      'Command of the workspace container, overrides the command of the image. The Daytona daemon is started alongside it',
+    type: [String],
+    example: ['npm', 'run', 'dev'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  cmd?: string[]
+
+  @ApiPropertyOptional({
+    description:
+      'Probe that must succeed before the workspace is reported as started. Either port or command must be set',
+    type: ReadinessProbeDto,
+  })
+  @IsOptional()
+  @ValidateNested()
+  @Type(() => ReadinessProbeDto)
+  readinessProbe?: ReadinessProbeDto
+
+  @ApiPropertyOptional({
+    description: 'Custom DNS servers of the workspace container',
+    type: [String],
+    example: ['1.1.1.1', '8.8.8.8'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  dns?: string[]
+
+  @ApiPropertyOptional({
+    description: 'Additional host-to-IP mappings of the workspace container (format: HOST:IP)',
+    type: [String],
+    example: ['db.internal:10.0.0.5'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  extraHosts?: string[]
+
+  @ApiPropertyOptional({
+    description:
+      'Mount the root filesystem of the workspace read-only. /tmp, /run and the home directory of the user stay writable',
+    example: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  readonlyRootfs?: boolean
+
+  @ApiPropertyOptional({
+    description:
+      'Additional tmpfs mounts of the workspace container (format: PATH[:OPTIONS], e.g. /var/cache:size=64m)',
+    type: [String],
+    example: ['/var/cache:size=64m'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  tmpfs?: string[]
+
+  @ApiPropertyOptional({
+    description:
+      'Security profiles and capabilities of the workspace container. Setting them makes the workspace unprivileged',
+    type: WorkspaceSecurityDto,
+  })
+  @IsOptional()
+  @ValidateNested()
+  @Type(() => WorkspaceSecurityDto)
+  security?: WorkspaceSecurityDto
+
+  @ApiPropertyOptional({
+    description:
+      'Run the workspace container privileged, needed for Docker-in-Docker. Defaults to true if the node allows privileged workspaces and no security settings are given',
+    example: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  privileged?: boolean
+
+  @ApiPropertyOptional({
+    description:
+      'Bind mount the Docker socket of the node into the workspace. This gives the workspace full control over the Docker daemon of the node',
+    example: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  mountDockerSocket?: boolean
+
+  @ApiPropertyOptional({
+    description:
+      'Resource limits of the workspace processes. nofile defaults to a soft limit of 65536 and a hard limit of 1048576',
+    type: [WorkspaceUlimitDto],
+  })
+  @IsOptional()
+  @IsArray()
+  @ValidateNested({ each: true })
+  @Type(() => WorkspaceUlimitDto)
+  ulimits?: WorkspaceUlimitDto[]
+
+  @ApiPropertyOptional({
+    description:
+      'Steps that run to completion in order before the workspace first starts, sharing the volumes of the workspace',
+    type: [WorkspaceInitStepDto],
+  })
+  @IsOptional()
+  @IsArray()
+  @ValidateNested({ each: true })
+  @Type(() => WorkspaceInitStepDto)
+  initSteps?: WorkspaceInitStepDto[]
+
+  @ApiPropertyOptional({
+    description:
+      'Files written into the workspace before it first starts. With a read-only root filesystem, paths must be on a volume',
+    type: [WorkspaceFileDto],
+  })
+  @IsOptional()
+  @IsArray()
+  @ValidateNested({ each: true })
+  @Type(() => WorkspaceFileDto)
+  files?: WorkspaceFileDto[]
+
+  @ApiPropertyOptional({
+    description: 'Hostname of the workspace container, defaults to the workspace ID',
+    example: 'dev',
+  })
+  @IsOptional()
+  @IsString()
+  @Matches(/^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$/, { message: 'hostname must be a valid DNS label' })
+  hostname?: string
+
+  @ApiPropertyOptional({
+    description: 'Domain name of the workspace container',
+    example: 'example.internal',
+  })
+  @IsOptional()
+  @IsString()
+  domainname?: string
+
+  @ApiPropertyOptional({
+    description:
+      'Seconds the workspace gets to shut down after SIGTERM when it is stopped before it is killed, e.g. to let a database flush. Killed immediately by default',
+    example: 30,
+    type: 'integer',
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  stopGracePeriodSeconds?: number
+
+  @ApiPropertyOptional({
+    description:
+      'Image pull policy of the workspace and init step images. Defaults to always for images tagged latest and if-not-present otherwise',
+    enum: ImagePullPolicy,
+    example: ImagePullPolicy.IF_NOT_PRESENT,
+  })
+  @IsOptional()
+  @IsEnum(ImagePullPolicy)
+  imagePullPolicy?: ImagePullPolicy
+
+  @ApiPropertyOptional({
+    description:
+      'Shell commands run in order inside the workspace after it first starts and becomes ready, e.g. to install dependencies',
+    type: [String],
+    example: ['npm install'],
+  })
+  @IsOptional()
+  @IsArray()
+  @IsString({ each: true })
+  postCreateCommands?: string[]
+
+  @ApiPropertyOptional({
+    description: 'Keep the workspace when a post-create command fails instead of failing the creation',
+    example: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  postCreateContinueOnError?: boolean
 }
@@ -168,6 +168,35 @@ export class GitRepoRequestDto {
   password?: string
 }
 
+@ApiSchema({ name: 'GitResetRequest' })
+export class GitResetRequestDto {
+  @ApiProperty()
+  path: string
+
+  @ApiPropertyOptional()
+  username?: string
+
+  @ApiPropertyOptional()
+  password?: string
+
+  @ApiPropertyOptional({
+    description: 'hard (default) discards local changes, stash stashes them before resetting',
+    enum: ['hard', 'stash'],
+  })
+  mode?: string
+
+  @ApiPropertyOptional({
+    description: 'Required to hard reset a repository with uncommitted changes',
+  })
+  discard_changes?: boolean
+}
+
+@ApiSchema({ name: 'GitResetResponse' })
+export class GitResetResponseDto {
+  @ApiProperty()
+  hash: string
+}
+
 @ApiSchema({ name: 'FileStatus' })
 export class FileStatusDto {
   @ApiProperty()
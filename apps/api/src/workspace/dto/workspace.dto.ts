@@ -33,6 +33,32 @@ export class WorkspaceInfoDto {
   })
   @IsOptional()
   providerMetadata?: string
+
+  @ApiPropertyOptional({
+    description: 'Registry digest of the image the workspace is running, only included in verbose output',
+    example: 'ubuntu@sha256:2e863c44b718727c860746568e1d54afd13b2fa71b160f5cd9058fc436217b30',
+    required: false,
+  })
+  @IsOptional()
+  imageDigest?: string
+
+  @ApiPropertyOptional({
+    description: 'Number of times the workspace was restarted by its restart policy, only included in verbose output',
+    example: 0,
+    required: false,
+  })
+  @IsOptional()
+  restartCount?: number
+
+  @ApiPropertyOptional({
+    description: 'Durations in seconds of the steps of the last workspace creation, only included in verbose output',
+    example: { pull: 1.5, create: 0.3, start: 0.8 },
+    type: 'object',
+    additionalProperties: { type: 'number' },
+    required: false,
+  })
+  @IsOptional()
+  phaseDurations?: { [phase: string]: number }
 }
 
 @ApiSchema({ name: 'WorkspaceVolume' })
@@ -274,7 +300,10 @@ export class WorkspaceDto {
         }
         break
       case WorkspaceState.UNKNOWN:
-        if (workspace.desiredState === WorkspaceDesiredState.STARTED) {
+        if (
+          workspace.desiredState === WorkspaceDesiredState.STARTED ||
+          workspace.desiredState === WorkspaceDesiredState.STOPPED
+        ) {
           return WorkspaceState.CREATING
         }
         break
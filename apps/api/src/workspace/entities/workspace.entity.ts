@@ -23,6 +23,14 @@ import { SnapshotState } from '../enums/snapshot-state.enum'
 import { nanoid } from 'nanoid'
 import { WorkspaceVolume } from '../dto/workspace.dto'
 import { BuildInfo } from './build-info.entity'
+import { ImagePullPolicy } from '../enums/image-pull-policy.enum'
+import {
+  ReadinessProbeDto,
+  WorkspaceFileDto,
+  WorkspaceInitStepDto,
+  WorkspaceSecurityDto,
+  WorkspaceUlimitDto,
+} from '../dto/create-workspace.dto'
 
 @Entity()
 export class Workspace {
@@ -161,6 +169,75 @@ export class Workspace {
   @Column({ default: () => 'MD5(random()::text)' })
   authToken: string
 
+  //  overrides of the entrypoint and command of the image
+  @Column('jsonb', { nullable: true })
+  entrypoint?: string[]
+
+  @Column('jsonb', { nullable: true })
+  cmd?: string[]
+
+  @Column('jsonb', { nullable: true })
+  readinessProbe?: ReadinessProbeDto
+
+  @Column('jsonb', { nullable: true })
+  dns?: string[]
+
+  @Column('jsonb', { nullable: true })
+  extraHosts?: string[]
+
+  @Column({ default: false })
+  readonlyRootfs: boolean
+
+  @Column('jsonb', { nullable: true })
+  tmpfs?: string[]
+
+  @Column('jsonb', { nullable: true })
+  security?: WorkspaceSecurityDto
+
+  //  unset keeps the default of the node
+  @Column({ nullable: true })
+  privileged?: boolean
+
+  @Column({ default: false })
+  mountDockerSocket: boolean
+
+  @Column('jsonb', { nullable: true })
+  ulimits?: WorkspaceUlimitDto[]
+
+  @Column({ nullable: true })
+  hostname?: string
+
+  @Column({ nullable: true })
+  domainname?: string
+
+  //  seconds the workspace gets to shut down after SIGTERM when it is stopped, it is killed immediately if not set
+  @Column({ type: 'int', nullable: true })
+  stopGracePeriodSeconds?: number
+
+  //  setup applied once when the workspace is created
+  @Column('jsonb', { nullable: true })
+  initSteps?: WorkspaceInitStepDto[]
+
+  @Column('jsonb', { nullable: true })
+  files?: WorkspaceFileDto[]
+
+  @Column({ nullable: true })
+  imagePullPolicy?: ImagePullPolicy
+
+  @Column('jsonb', { nullable: true })
+  postCreateCommands?: string[]
+
+  @Column({ default: false })
+  postCreateContinueOnError: boolean
+
+  //  grace period of the pending stop in seconds, overrides stopGracePeriodSeconds
+  @Column({ type: 'int', nullable: true })
+  stopTimeout?: number
+
+  //  the image of the workspace is built without the build cache instead of reusing an image built before
+  @Column({ default: false })
+  buildNoCache: boolean
+
   @ManyToOne(() => BuildInfo, (buildInfo) => buildInfo.workspaces, {
     nullable: true,
     eager: true,
@@ -204,9 +281,18 @@ export class Workspace {
         throw new Error(`Workspace ${this.id} is not in a valid state to be started. State: ${this.state}`)
       case WorkspaceDesiredState.STOPPED:
         if (
-          [WorkspaceState.STARTED, WorkspaceState.STOPPING, WorkspaceState.STOPPED, WorkspaceState.ERROR].includes(
-            this.state,
-          )
+          [
+            WorkspaceState.STARTED,
+            WorkspaceState.STOPPING,
+            WorkspaceState.STOPPED,
+            WorkspaceState.ERROR,
+            //  states of workspaces created without starting
+            WorkspaceState.UNKNOWN,
+            WorkspaceState.CREATING,
+            WorkspaceState.PENDING_BUILD,
+            WorkspaceState.BUILDING_IMAGE,
+            WorkspaceState.PULLING_IMAGE,
+          ].includes(this.state)
         ) {
           break
         }
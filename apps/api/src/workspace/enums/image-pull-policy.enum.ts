@@ -0,0 +1,10 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+export enum ImagePullPolicy {
+  ALWAYS = 'always',
+  IF_NOT_PRESENT = 'if-not-present',
+  NEVER = 'never',
+}
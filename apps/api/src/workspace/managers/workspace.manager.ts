@@ -33,7 +33,10 @@ import { WorkspaceStartedEvent } from '../events/workspace-started.event'
 import { WorkspaceArchivedEvent } from '../events/workspace-archived.event'
 import { WorkspaceDestroyedEvent } from '../events/workspace-destroyed.event'
 import { WorkspaceCreatedEvent } from '../events/workspace-create.event'
+import { WorkspaceRebuiltEvent } from '../events/workspace-rebuilt.event'
 import { ImageNode } from '../entities/image-node.entity'
+import { Node } from '../entities/node.entity'
+import axios from 'axios'
 
 type BreakFromSwitch = boolean
 const SYNC_INSTANCE_STATE_LOCK_KEY = 'sync-instance-state-'
@@ -97,6 +100,15 @@ export class WorkspaceManager {
             }
 
             try {
+              //  activity inside the sandbox (terminal, toolbox calls that bypass the API) keeps it running
+              const lastActivityAt = await this.getDaemonLastActivity(node, workspace)
+              if (lastActivityAt && lastActivityAt.getTime() > Date.now() - workspace.autoStopInterval * 60 * 1000) {
+                await this.workspaceRepository.update(workspace.id, { lastActivityAt })
+                await this.redisLockProvider.unlock(lockKey)
+                return
+              }
+
+              workspace.stopTimeout = null
               workspace.desiredState = WorkspaceDesiredState.STOPPED
               await this.workspaceRepository.save(workspace)
               await this.redisLockProvider.unlock(lockKey)
@@ -113,6 +125,25 @@ export class WorkspaceManager {
     )
   }
 
+  //  returns the last activity recorded by the daemon or undefined if it can not be determined
+  private async getDaemonLastActivity(node: Node, workspace: Workspace): Promise<Date | undefined> {
+    try {
+      const response = await axios.get(`${node.apiUrl}/workspaces/${workspace.id}/main/toolbox/activity`, {
+        headers: {
+          Authorization: `Bearer ${node.apiKey}`,
+        },
+        timeout: 5000,
+      })
+      if (!response.data?.lastActivity) {
+        return undefined
+      }
+      return new Date(response.data.lastActivity)
+    } catch (error) {
+      this.logger.warn(`Failed to get daemon activity for workspace ${workspace.id}: ${error.message}`)
+      return undefined
+    }
+  }
+
   @Cron(CronExpression.EVERY_10_SECONDS, { name: 'sync-states' })
   async syncStates(): Promise<void> {
     const lockKey = 'sync-states'
@@ -240,11 +271,14 @@ export class WorkspaceManager {
     // Try to assign an available node with the image build
     let nodeId: string
     try {
-      nodeId = await this.nodeService.getRandomAvailableNode({
-        region: workspace.region,
-        workspaceClass: workspace.class,
-        imageRef: workspace.buildInfo.imageRef,
-      })
+      //  workspaces built without the build cache don't reuse an image that is built or being built
+      if (!workspace.buildNoCache) {
+        nodeId = await this.nodeService.getRandomAvailableNode({
+          region: workspace.region,
+          workspaceClass: workspace.class,
+          imageRef: workspace.buildInfo.imageRef,
+        })
+      }
     } catch (error) {
       // Continue to next assignment method
     }
@@ -257,7 +291,7 @@ export class WorkspaceManager {
     }
 
     // Try to assign an available node that is currently building the image
-    const imageNodes = await this.nodeService.getImageNodes(workspace.buildInfo.imageRef)
+    const imageNodes = workspace.buildNoCache ? [] : await this.nodeService.getImageNodes(workspace.buildInfo.imageRef)
 
     for (const imageNode of imageNodes) {
       const node = await this.nodeService.findOne(imageNode.nodeId)
@@ -286,7 +320,12 @@ export class WorkspaceManager {
       excludedNodeIds: excludedNodeIds,
     })
 
-    this.buildOnNode(workspace.buildInfo, nodeId, workspace.organizationId)
+    //  the image built before is only available again once the build without the build cache finishes
+    if (workspace.buildNoCache) {
+      await this.nodeService.removeImageNodes(nodeId, workspace.buildInfo.imageRef)
+    }
+
+    this.buildOnNode(workspace.buildInfo, nodeId, workspace.organizationId, workspace.buildNoCache)
 
     await this.updateWorkspaceState(workspace.id, WorkspaceState.BUILDING_IMAGE, nodeId)
     await this.nodeService.recalculateNodeUsage(nodeId)
@@ -295,7 +334,7 @@ export class WorkspaceManager {
   }
 
   // Initiates the image build on the runner and creates an ImageNode depending on the result
-  async buildOnNode(buildInfo: BuildInfo, nodeId: string, organizationId: string) {
+  async buildOnNode(buildInfo: BuildInfo, nodeId: string, organizationId: string, noCache = false) {
     const node = await this.nodeService.findOne(nodeId)
     const nodeImageApi = this.nodeApiFactory.createImageApi(node)
 
@@ -308,6 +347,7 @@ export class WorkspaceManager {
           organizationId: organizationId,
           dockerfile: buildInfo.dockerfileContent,
           context: buildInfo.contextHashes,
+          noCache,
         })
         break
       } catch (err) {
@@ -531,11 +571,11 @@ export class WorkspaceManager {
         break
       }
       case WorkspaceState.BUILDING_IMAGE: {
-        await this.handleNodeWorkspaceBuildingImageStateOnDesiredStateStart(workspace)
+        await this.handleNodeWorkspaceBuildingImageState(workspace)
         break
       }
       case WorkspaceState.UNKNOWN: {
-        await this.handleNodeWorkspaceUnknownStateOnDesiredStateStart(workspace)
+        await this.handleNodeWorkspaceUnknownState(workspace)
         break
       }
       case WorkspaceState.ARCHIVED:
@@ -584,6 +624,19 @@ export class WorkspaceManager {
     const workspace = await this.workspaceRepository.findOneByOrFail({
       id: workspaceId,
     })
+
+    //  workspaces created without starting are built and created like started ones, only the start is skipped
+    switch (workspace.state) {
+      case WorkspaceState.PENDING_BUILD: {
+        await this.handleUnassignedBuildWorkspace(workspace)
+        return
+      }
+      case WorkspaceState.BUILDING_IMAGE: {
+        await this.handleNodeWorkspaceBuildingImageState(workspace)
+        return
+      }
+    }
+
     const node = await this.nodeService.findOne(workspace.nodeId)
     if (node.state !== NodeState.READY) {
       //  console.debug(`Node ${node.id} is not ready`);
@@ -591,10 +644,28 @@ export class WorkspaceManager {
     }
 
     switch (workspace.state) {
+      case WorkspaceState.UNKNOWN: {
+        await this.handleNodeWorkspaceUnknownState(workspace)
+        break
+      }
+      case WorkspaceState.CREATING:
+      case WorkspaceState.PULLING_IMAGE: {
+        if (await this.handleNodeWorkspacePullingImageStateCheck(workspace)) {
+          break
+        }
+        // check if the workspace was created on the node
+        const nodeWorkspaceApi = this.nodeApiFactory.createWorkspaceApi(node)
+        const workspaceInfoResponse = await nodeWorkspaceApi.info(workspace.id)
+        const workspaceInfo = workspaceInfoResponse.data
+        if (workspaceInfo.state === NodeWorkspaceState.SandboxStateStopped) {
+          await this.updateWorkspaceState(workspace.id, WorkspaceState.STOPPED)
+        }
+        break
+      }
       case WorkspaceState.STARTED: {
         // stop workspace
         const nodeWorkspaceApi = this.nodeApiFactory.createWorkspaceApi(node)
-        await nodeWorkspaceApi.stop(workspace.id)
+        await nodeWorkspaceApi.stop(workspace.id, workspace.stopTimeout ?? undefined)
         await this.updateWorkspaceState(workspace.id, WorkspaceState.STOPPING)
         //  sync states again immediately for workspace
         await this.redisLockProvider.unlock(SYNC_INSTANCE_STATE_LOCK_KEY + workspace.id)
@@ -645,7 +716,7 @@ export class WorkspaceManager {
     }
   }
 
-  private async handleNodeWorkspaceBuildingImageStateOnDesiredStateStart(workspace: Workspace) {
+  private async handleNodeWorkspaceBuildingImageState(workspace: Workspace) {
     const imageNode = await this.nodeService.getImageNode(workspace.nodeId, workspace.buildInfo.imageRef)
     if (imageNode) {
       switch (imageNode.state) {
@@ -671,7 +742,7 @@ export class WorkspaceManager {
     }
   }
 
-  private async handleNodeWorkspaceUnknownStateOnDesiredStateStart(workspace: Workspace) {
+  private async handleNodeWorkspaceUnknownState(workspace: Workspace) {
     const node = await this.nodeService.findOne(workspace.nodeId)
     if (node.state !== NodeState.READY) {
       //  console.debug(`Node ${node.id} is not ready`);
@@ -691,6 +762,14 @@ export class WorkspaceManager {
       env: workspace.env,
       // public: workspace.public,
       volumes: workspace.volumes,
+      noStart: workspace.desiredState === WorkspaceDesiredState.STOPPED,
+      cmd: workspace.cmd,
+      initSteps: workspace.initSteps,
+      files: workspace.files,
+      imagePullPolicy: workspace.imagePullPolicy,
+      postCreateCommands: workspace.postCreateCommands,
+      postCreateContinueOnError: workspace.postCreateContinueOnError,
+      ...this.getContainerOptions(workspace),
     }
 
     if (!workspace.buildInfo) {
@@ -706,7 +785,7 @@ export class WorkspaceManager {
       createWorkspaceDto = {
         ...createWorkspaceDto,
         image: internalImageName,
-        entrypoint: image.entrypoint,
+        entrypoint: workspace.entrypoint ?? image.entrypoint,
         registry: {
           url: registry.url,
           username: registry.username,
@@ -717,7 +796,7 @@ export class WorkspaceManager {
       createWorkspaceDto = {
         ...createWorkspaceDto,
         image: workspace.buildInfo.imageRef,
-        entrypoint: this.getEntrypointFromDockerfile(workspace.buildInfo.dockerfileContent),
+        entrypoint: workspace.entrypoint ?? this.getEntrypointFromDockerfile(workspace.buildInfo.dockerfileContent),
       }
     }
 
@@ -729,6 +808,24 @@ export class WorkspaceManager {
     this.syncInstanceState(workspace.id)
   }
 
+  //  options of the workspace container, applied again when the workspace is restored from a snapshot
+  private getContainerOptions(workspace: Workspace): Partial<CreateSandboxDTO> {
+    return {
+      readinessProbe: workspace.readinessProbe,
+      dns: workspace.dns,
+      extraHosts: workspace.extraHosts,
+      readonlyRootfs: workspace.readonlyRootfs,
+      tmpfs: workspace.tmpfs,
+      security: workspace.security,
+      privileged: workspace.privileged,
+      mountDockerSocket: workspace.mountDockerSocket,
+      ulimits: workspace.ulimits,
+      hostname: workspace.hostname,
+      domainname: workspace.domainname,
+      stopGracePeriodSeconds: workspace.stopGracePeriodSeconds,
+    }
+  }
+
   // TODO: revise/cleanup
   private getEntrypointFromDockerfile(dockerfileContent: string): string[] {
     // Match ENTRYPOINT with either a string or JSON array
@@ -913,6 +1010,7 @@ export class WorkspaceManager {
           username: registry.username,
           password: registry.password,
         },
+        ...this.getContainerOptions(workspace),
       })
 
       await this.updateWorkspaceState(workspace.id, WorkspaceState.RESTORING, nodeId)
@@ -1095,6 +1193,35 @@ export class WorkspaceManager {
     this.syncInstanceState(event.workspace.id).catch(this.logger.error)
   }
 
+  @OnEvent(WorkspaceEvents.REBUILT)
+  private async handleWorkspaceRebuiltEvent(event: WorkspaceRebuiltEvent) {
+    //  awaited by the rebuild request, which returns once the workspace is pending the build
+    await this.rebuildWorkspace(event.workspace.id)
+  }
+
+  //  removes the container of the workspace, which is then built and created again like a new workspace
+  private async rebuildWorkspace(workspaceId: string): Promise<void> {
+    const workspace = await this.workspaceRepository.findOneByOrFail({
+      id: workspaceId,
+    })
+
+    const node = await this.nodeService.findOne(workspace.nodeId)
+    const nodeWorkspaceApi = this.nodeApiFactory.createWorkspaceApi(node)
+    try {
+      await nodeWorkspaceApi.destroy(workspace.id)
+    } catch (e) {
+      //  if the workspace is not found on node, it is already destroyed
+      if (!e.response || e.response.status !== 404) {
+        await this.updateWorkspaceErrorState(workspace.id, e.message || String(e))
+        return
+      }
+    }
+
+    await this.updateWorkspaceState(workspace.id, WorkspaceState.PENDING_BUILD)
+    await this.nodeService.recalculateNodeUsage(node.id)
+    this.syncInstanceState(workspace.id)
+  }
+
   @OnEvent(WorkspaceEvents.CREATED)
   private async handleWorkspaceCreatedEvent(event: WorkspaceCreatedEvent) {
     this.syncInstanceState(event.workspace.id).catch(this.logger.error)
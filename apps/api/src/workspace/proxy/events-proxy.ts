@@ -0,0 +1,44 @@
+/*
+ * Copyright 2025 Daytona Platforms Inc.
+ * SPDX-License-Identifier: AGPL-3.0
+ */
+
+import { createProxyMiddleware, Options } from 'http-proxy-middleware'
+import { IncomingMessage, ServerResponse } from 'http'
+import { NextFunction } from 'express'
+
+export class EventsProxy {
+  constructor(
+    private readonly targetUrl: string,
+    private readonly workspaceId: string,
+    private readonly authToken: string,
+    private readonly since: string | undefined,
+    private readonly req: IncomingMessage,
+    private readonly res: ServerResponse<IncomingMessage>,
+    private readonly next: NextFunction,
+  ) {}
+
+  create() {
+    const proxyOptions: Options = {
+      target: this.targetUrl,
+      secure: false,
+      changeOrigin: true,
+      autoRewrite: true,
+      pathRewrite: () => {
+        let path = `/workspaces/${encodeURIComponent(this.workspaceId)}/events`
+        if (this.since) {
+          path += `?since=${encodeURIComponent(this.since)}`
+        }
+        return path
+      },
+      on: {
+        proxyReq: (proxyReq: any) => {
+          proxyReq.setHeader('Authorization', `Bearer ${this.authToken}`)
+          proxyReq.setHeader('Accept', 'application/x-ndjson')
+        },
+      },
+    }
+
+    return createProxyMiddleware(proxyOptions)(this.req, this.res, this.next)
+  }
+}
@@ -19,6 +19,7 @@ export class LogProxy {
     private readonly req: IncomingMessage,
     private readonly res: ServerResponse<IncomingMessage>,
     private readonly next: NextFunction,
+    private readonly offset?: number,
   ) {}
 
   create() {
@@ -27,7 +28,13 @@ export class LogProxy {
       secure: false,
       changeOrigin: true,
       autoRewrite: true,
-      pathRewrite: () => `/images/logs?imageRef=${this.imageRef}&follow=${this.follow}`,
+      pathRewrite: () => {
+        let path = `/images/logs?imageRef=${this.imageRef}&follow=${this.follow}`
+        if (this.offset !== undefined) {
+          path += `&offset=${this.offset}`
+        }
+        return path
+      },
       on: {
         proxyReq: (proxyReq: any, req: any) => {
           proxyReq.setHeader('Authorization', `Bearer ${this.authToken}`)
@@ -21,6 +21,7 @@ import { WorkspaceState } from './../../workspace/enums/workspace-state.enum'
 import { Workspace } from './../../workspace/entities/workspace.entity'
 import { ImageNode } from './../../workspace/entities/image-node.entity'
 import { ImageNodeState } from './../../workspace/enums/image-node-state.enum'
+import { SandboxInfoResponse } from '@daytonaio/runner-api-client'
 
 @Injectable()
 export class NodeService {
@@ -129,6 +130,12 @@ export class NodeService {
     await this.recalculateNodeUsage(event.workspace.nodeId)
   }
 
+  async getWorkspaceInfo(node: Node, workspaceId: string): Promise<SandboxInfoResponse> {
+    const nodeWorkspaceApi = this.nodeApiFactory.createWorkspaceApi(node)
+    const response = await nodeWorkspaceApi.info(workspaceId, true)
+    return response.data
+  }
+
   @Cron('45 * * * * *')
   private async handleCheckNodes() {
     if (this.checkingNodes) {
@@ -251,6 +258,10 @@ export class NodeService {
     await this.imageNodeRepository.save(imageNode)
   }
 
+  async removeImageNodes(nodeId: string, imageRef: string): Promise<void> {
+    await this.imageNodeRepository.delete({ nodeId, imageRef })
+  }
+
   async getNodesWithMultipleImagesBuilding(maxImageCount = 2): Promise<string[]> {
     const nodes = await this.workspaceRepository
       .createQueryBuilder('workspace')
@@ -37,6 +37,7 @@ import { WorkspaceSnapshotCreatedEvent } from '../events/workspace-snapshot-crea
 import { WorkspaceDestroyedEvent } from '../events/workspace-destroyed.event'
 import { WorkspaceStartedEvent } from '../events/workspace-started.event'
 import { WorkspaceStoppedEvent } from '../events/workspace-stopped.event'
+import { WorkspaceRebuiltEvent } from '../events/workspace-rebuilt.event'
 import { WorkspaceArchivedEvent } from '../events/workspace-archived.event'
 import { OrganizationService } from '../../organization/services/organization.service'
 import { OrganizationEvents } from '../../organization/constants/organization-events.constant'
@@ -227,7 +228,13 @@ export class WorkspaceService {
         throw new BadRequestError(`Image ${workspaceImage} not found. Did you add it through the Daytona Dashboard?`)
       }
 
-      if (organizationId !== WORKSPACE_WARM_POOL_UNASSIGNED_ORGANIZATION) {
+      //  warm pool workspaces are already started with the default container configuration,
+      //  they can't be used for workspaces created without starting or with container options
+      if (
+        organizationId !== WORKSPACE_WARM_POOL_UNASSIGNED_ORGANIZATION &&
+        !createWorkspaceDto.noStart &&
+        !this.hasContainerOptions(createWorkspaceDto)
+      ) {
         const warmPoolWorkspace = await this.warmPoolService.fetchWarmPoolWorkspace({
           organizationId: organizationId,
           image: workspaceImage,
@@ -282,6 +289,31 @@ export class WorkspaceService {
 
     workspace.public = createWorkspaceDto.public || false
 
+    workspace.entrypoint = createWorkspaceDto.entrypoint
+    workspace.cmd = createWorkspaceDto.cmd
+    workspace.readinessProbe = createWorkspaceDto.readinessProbe
+    workspace.dns = createWorkspaceDto.dns
+    workspace.extraHosts = createWorkspaceDto.extraHosts
+    workspace.readonlyRootfs = createWorkspaceDto.readonlyRootfs || false
+    workspace.tmpfs = createWorkspaceDto.tmpfs
+    workspace.security = createWorkspaceDto.security
+    workspace.privileged = createWorkspaceDto.privileged
+    workspace.mountDockerSocket = createWorkspaceDto.mountDockerSocket || false
+    workspace.ulimits = createWorkspaceDto.ulimits
+    workspace.initSteps = createWorkspaceDto.initSteps
+    workspace.files = createWorkspaceDto.files
+    workspace.hostname = createWorkspaceDto.hostname
+    workspace.domainname = createWorkspaceDto.domainname
+    workspace.stopGracePeriodSeconds = createWorkspaceDto.stopGracePeriodSeconds
+    workspace.imagePullPolicy = createWorkspaceDto.imagePullPolicy
+    workspace.postCreateCommands = createWorkspaceDto.postCreateCommands
+    workspace.postCreateContinueOnError = createWorkspaceDto.postCreateContinueOnError || false
+
+    //  the workspace is provisioned on the node and stays stopped until it is started
+    if (createWorkspaceDto.noStart) {
+      workspace.desiredState = WorkspaceDesiredState.STOPPED
+    }
+
     if (createWorkspaceDto.buildInfo) {
       const buildInfoImageRef = generateBuildImageRef(
         createWorkspaceDto.buildInfo.dockerfileContent,
@@ -303,6 +335,7 @@ export class WorkspaceService {
         await this.buildInfoRepository.save(buildInfoEntity)
         workspace.buildInfo = buildInfoEntity
       }
+      workspace.buildNoCache = Boolean(createWorkspaceDto.buildInfo.noCache)
     }
 
     if (createWorkspaceDto.autoStopInterval !== undefined) {
@@ -311,23 +344,55 @@ export class WorkspaceService {
 
     const imageRef = workspace.buildInfo ? workspace.buildInfo.imageRef : image.internalName
 
-    try {
-      workspace.nodeId = await this.nodeService.getRandomAvailableNode({
-        region: workspace.region,
-        workspaceClass: workspace.class,
-        imageRef,
-      })
-    } catch (error) {
-      if (error instanceof BadRequestError == false || error.message !== 'No available nodes' || !workspace.buildInfo) {
-        throw error
-      }
+    //  images built without the build cache are built again even if a node already has them
+    if (workspace.buildNoCache) {
       workspace.state = WorkspaceState.PENDING_BUILD
+    } else {
+      try {
+        workspace.nodeId = await this.nodeService.getRandomAvailableNode({
+          region: workspace.region,
+          workspaceClass: workspace.class,
+          imageRef,
+        })
+      } catch (error) {
+        if (
+          error instanceof BadRequestError == false ||
+          error.message !== 'No available nodes' ||
+          !workspace.buildInfo
+        ) {
+          throw error
+        }
+        workspace.state = WorkspaceState.PENDING_BUILD
+      }
     }
 
     await this.workspaceRepository.insert(workspace)
     return workspace
   }
 
+  private hasContainerOptions(createWorkspaceDto: CreateWorkspaceDto): boolean {
+    return Boolean(
+      createWorkspaceDto.entrypoint ||
+        createWorkspaceDto.cmd ||
+        createWorkspaceDto.readinessProbe ||
+        createWorkspaceDto.dns ||
+        createWorkspaceDto.extraHosts ||
+        createWorkspaceDto.readonlyRootfs ||
+        createWorkspaceDto.tmpfs ||
+        createWorkspaceDto.security ||
+        createWorkspaceDto.privileged !== undefined ||
+        createWorkspaceDto.mountDockerSocket ||
+        createWorkspaceDto.ulimits ||
+        createWorkspaceDto.initSteps ||
+        createWorkspaceDto.files ||
+        createWorkspaceDto.hostname ||
+        createWorkspaceDto.domainname ||
+        createWorkspaceDto.stopGracePeriodSeconds !== undefined ||
+        createWorkspaceDto.imagePullPolicy ||
+        createWorkspaceDto.postCreateCommands
+    )
+  }
+
   async createSnapshot(workspaceId: string): Promise<void> {
     const workspace = await this.workspaceRepository.findOne({
       where: {
@@ -474,7 +539,11 @@ export class WorkspaceService {
     this.eventEmitter.emit(WorkspaceEvents.STARTED, new WorkspaceStartedEvent(workspace))
   }
 
-  async stop(workspaceId: string): Promise<void> {
+  async stop(workspaceId: string, timeout?: number): Promise<void> {
+    if (timeout < 0) {
+      throw new WorkspaceError('Timeout must not be negative')
+    }
+
     const workspace = await this.workspaceRepository.findOne({
       where: {
         id: workspaceId,
@@ -497,12 +566,49 @@ export class WorkspaceService {
       throw new WorkspaceError('Workspace state change in progress')
     }
     workspace.pending = true
+    workspace.stopTimeout = timeout ?? null
     workspace.desiredState = WorkspaceDesiredState.STOPPED
     await this.workspaceRepository.save(workspace)
 
     this.eventEmitter.emit(WorkspaceEvents.STOPPED, new WorkspaceStoppedEvent(workspace))
   }
 
+  async rebuild(workspaceId: string, noCache: boolean): Promise<void> {
+    const workspace = await this.workspaceRepository.findOne({
+      where: {
+        id: workspaceId,
+      },
+    })
+
+    if (!workspace) {
+      throw new NotFoundException(`Workspace with ID ${workspaceId} not found`)
+    }
+
+    if (!workspace.buildInfo) {
+      throw new WorkspaceError('Only workspaces created from a Dockerfile can be rebuilt')
+    }
+
+    if (String(workspace.state) !== String(workspace.desiredState)) {
+      throw new WorkspaceError('State change in progress')
+    }
+
+    if (![WorkspaceState.STARTED, WorkspaceState.STOPPED].includes(workspace.state)) {
+      throw new WorkspaceError('Workspace is not in valid state')
+    }
+
+    if (workspace.pending) {
+      throw new WorkspaceError('Workspace state change in progress')
+    }
+
+    //  the container is recreated from the rebuilt image and started, changes made in the workspace are lost
+    workspace.pending = true
+    workspace.buildNoCache = noCache
+    workspace.desiredState = WorkspaceDesiredState.STARTED
+    await this.workspaceRepository.save(workspace)
+
+    await this.eventEmitter.emitAsync(WorkspaceEvents.REBUILT, new WorkspaceRebuiltEvent(workspace))
+  }
+
   async updatePublicStatus(workspaceId: string, isPublic: boolean): Promise<void> {
     const workspace = await this.workspaceRepository.findOne({
       where: { id: workspaceId },
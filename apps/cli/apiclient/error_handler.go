@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/daytonaio/daytona/cli/internal"
 
@@ -20,6 +21,42 @@ const API_VERSION_HEADER = "X-Daytona-Api-Version"
 type ApiErrorResponse struct {
 	Error   string `json:"error"`
 	Message any    `json:"message,omitempty"`
+	Code    string `json:"code,omitempty"`
+}
+
+// Sentinel errors matched by ApiError through errors.Is
+var (
+	ErrValidation   = errors.New("validation failed")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:          ErrValidation,
+	http.StatusUnprocessableEntity: ErrValidation,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusConflict:            ErrConflict,
+}
+
+// ApiError is returned by HandleErrorResponse for error responses from the API.
+// Code is the machine-readable code of the response, or derived from the status code
+// when the response does not include one.
+type ApiError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *ApiError) Error() string {
+	return e.Message
+}
+
+func (e *ApiError) Is(target error) bool {
+	return statusErrors[e.StatusCode] == target
 }
 
 func HandleErrorResponse(res *http.Response, requestErr error) error {
@@ -34,14 +71,12 @@ func HandleErrorResponse(res *http.Response, requestErr error) error {
 		return err
 	}
 
-	var errResponse ApiErrorResponse
-	err = json.Unmarshal(body, &errResponse)
-	if err != nil {
-		return err
-	}
-
 	checkVersionsMismatch(res)
 
+	var errResponse ApiErrorResponse
+	// Bodies that aren't JSON are returned as the message as is
+	_ = json.Unmarshal(body, &errResponse)
+
 	errMessage := string(errResponse.Error)
 	if errMessage == "" {
 		// Fall back to raw body if error field is empty
@@ -65,7 +100,16 @@ func HandleErrorResponse(res *http.Response, requestErr error) error {
 		errMessage += " - run 'daytona login' to reauthenticate"
 	}
 
-	return errors.New(errMessage)
+	code := errResponse.Code
+	if code == "" {
+		code = strings.ReplaceAll(strings.ToUpper(http.StatusText(res.StatusCode)), " ", "_")
+	}
+
+	return &ApiError{
+		StatusCode: res.StatusCode,
+		Code:       code,
+		Message:    errMessage,
+	}
 }
 
 func checkVersionsMismatch(res *http.Response) {
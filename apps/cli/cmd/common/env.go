@@ -0,0 +1,49 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ParseEnv parses KEY=VALUE environment variable arguments. A value starting with @ is
+// the path of a file whose contents are used as the value, e.g. KEY=@./cert.pem, and
+// @@ escapes a literal leading @. Arguments without = are ignored.
+func ParseEnv(args []string) (map[string]string, error) {
+	env := make(map[string]string, len(args))
+
+	for _, arg := range args {
+		key, value, found := strings.Cut(arg, "=")
+		if !found {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(value, "@@"):
+			value = value[1:]
+		case strings.HasPrefix(value, "@"):
+			path := value[1:]
+			if path == "" {
+				return nil, fmt.Errorf("missing file path for environment variable %s", key)
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the value of environment variable %s: %w", key, err)
+			}
+
+			if strings.ContainsRune(string(content), '\x00') {
+				return nil, fmt.Errorf("file %s for environment variable %s must not contain null characters", path, key)
+			}
+
+			value = string(content)
+		}
+
+		env[key] = value
+	}
+
+	return env, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const gistApiUrl = "https://api.github.com/gists"
+
+var gistIdRegex = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+
+// Base images for the most common language of a gist's files
+var gistLanguageImages = map[string]string{
+	"Python":     "python:3.12",
+	"JavaScript": "node:22",
+	"TypeScript": "node:22",
+	"Go":         "golang:1.23",
+	"Ruby":       "ruby:3.3",
+	"Rust":       "rust:1",
+	"Java":       "eclipse-temurin:21",
+}
+
+type Gist struct {
+	Id    string              `json:"id"`
+	Files map[string]GistFile `json:"files"`
+}
+
+type GistFile struct {
+	Filename  string `json:"filename"`
+	Language  string `json:"language"`
+	RawUrl    string `json:"raw_url"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated"`
+}
+
+// GetGistId returns the ID of a gist given either its ID or its URL, e.g. https://gist.github.com/user/ID
+func GetGistId(idOrUrl string) (string, error) {
+	id := idOrUrl
+
+	if strings.Contains(idOrUrl, "/") {
+		parsedUrl, err := url.Parse(idOrUrl)
+		if err != nil {
+			return "", fmt.Errorf("invalid gist URL %s: %w", idOrUrl, err)
+		}
+
+		segments := strings.Split(strings.Trim(parsedUrl.Path, "/"), "/")
+		id = strings.TrimSuffix(segments[len(segments)-1], ".git")
+	}
+
+	if !gistIdRegex.MatchString(id) {
+		return "", fmt.Errorf("invalid gist ID or URL %s", idOrUrl)
+	}
+
+	return id, nil
+}
+
+// FetchGist fetches a gist with the contents of all its files from the GitHub API.
+// GITHUB_TOKEN is used if set, which is required for secret gists.
+func FetchGist(ctx context.Context, idOrUrl string) (*Gist, error) {
+	id, err := GetGistId(idOrUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := getGistResource(ctx, fmt.Sprintf("%s/%s", gistApiUrl, id), "application/vnd.github+json")
+	if err != nil {
+		return nil, err
+	}
+
+	var gist Gist
+	err = json.Unmarshal(body, &gist)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gist %s: %w", id, err)
+	}
+
+	if len(gist.Files) == 0 {
+		return nil, fmt.Errorf("gist %s has no files", id)
+	}
+
+	for name, file := range gist.Files {
+		if strings.ContainsAny(name, `/\`) || name == ".." {
+			return nil, fmt.Errorf("gist %s has an invalid file name %s", id, name)
+		}
+
+		// The API only includes the first megabyte of large files
+		if file.Truncated {
+			content, err := getGistResource(ctx, file.RawUrl, "")
+			if err != nil {
+				return nil, err
+			}
+			file.Content = string(content)
+			gist.Files[name] = file
+		}
+	}
+
+	return &gist, nil
+}
+
+// GetGistImage returns a base image for the most common language of the gist's files, or an
+// empty string if none of them is known
+func GetGistImage(gist *Gist) string {
+	counts := map[string]int{}
+	for _, file := range gist.Files {
+		if languageImage, ok := gistLanguageImages[file.Language]; ok {
+			counts[languageImage]++
+		}
+	}
+
+	image := ""
+	for languageImage, count := range counts {
+		// Ties are broken by name so the choice is stable
+		if image == "" || count > counts[image] || (count == counts[image] && languageImage < image) {
+			image = languageImage
+		}
+	}
+
+	return image
+}
+
+func getGistResource(ctx context.Context, resourceUrl, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("gist not found at %s, set GITHUB_TOKEN to access secret gists", resourceUrl)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", resourceUrl, res.Status)
+	}
+
+	return io.ReadAll(res.Body)
+}
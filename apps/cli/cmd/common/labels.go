@@ -0,0 +1,80 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	MaxLabels           = 64
+	MaxLabelKeyLength   = 63
+	MaxLabelValueLength = 255
+)
+
+var labelKeyRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+
+// LabelValidationError lists every label that was rejected, with the reason
+type LabelValidationError struct {
+	Invalid map[string]string
+}
+
+func (e *LabelValidationError) Error() string {
+	labels := make([]string, 0, len(e.Invalid))
+	for label := range e.Invalid {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	reasons := make([]string, 0, len(labels))
+	for _, label := range labels {
+		reasons = append(reasons, fmt.Sprintf("  %q: %s", label, e.Invalid[label]))
+	}
+
+	return fmt.Sprintf("invalid labels:\n%s", strings.Join(reasons, "\n"))
+}
+
+// ParseLabels parses KEY=VALUE label arguments. Keys and values are trimmed of
+// surrounding whitespace and validated so labels can be safely used as selectors
+// and container labels.
+func ParseLabels(args []string) (map[string]string, error) {
+	labels := make(map[string]string, len(args))
+	invalid := make(map[string]string)
+
+	for _, arg := range args {
+		key, value, found := strings.Cut(arg, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch {
+		case !found:
+			invalid[arg] = "expected format KEY=VALUE"
+		case key == "":
+			invalid[arg] = "key must not be empty"
+		case len(key) > MaxLabelKeyLength:
+			invalid[arg] = fmt.Sprintf("key must be at most %d characters", MaxLabelKeyLength)
+		case !labelKeyRegex.MatchString(key):
+			invalid[arg] = "key must start and end with an alphanumeric character and contain only alphanumerics, '.', '_', '/' or '-'"
+		case len(value) > MaxLabelValueLength:
+			invalid[arg] = fmt.Sprintf("value must be at most %d characters", MaxLabelValueLength)
+		case strings.ContainsAny(value, "\n\r\x00"):
+			invalid[arg] = "value must not contain newlines or null characters"
+		default:
+			labels[key] = value
+		}
+	}
+
+	if len(invalid) > 0 {
+		return nil, &LabelValidationError{Invalid: invalid}
+	}
+
+	if len(labels) > MaxLabels {
+		return nil, fmt.Errorf("too many labels: %d, at most %d are allowed", len(labels), MaxLabels)
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Value of --tz and --locale that selects the setting of the host running the CLI
+const HostSetting = "local"
+
+var timezoneRegex = regexp.MustCompile(`^[A-Za-z0-9_+\-]+(/[A-Za-z0-9_+\-]+)*$`)
+
+// ResolveTimezone validates an IANA timezone name, e.g. Europe/Berlin, or returns the
+// timezone of the host for "local"
+func ResolveTimezone(tz string) (string, error) {
+	if tz == HostSetting {
+		return getHostTimezone()
+	}
+
+	if !timezoneRegex.MatchString(tz) {
+		return "", fmt.Errorf("invalid timezone %s, expected a name like Europe/Berlin", tz)
+	}
+
+	return tz, nil
+}
+
+// ResolveLocale returns the locale, e.g. en_US.UTF-8, or the locale of the host for "local"
+func ResolveLocale(locale string) (string, error) {
+	if locale != HostSetting {
+		return locale, nil
+	}
+
+	for _, key := range []string{"LC_ALL", "LANG"} {
+		if value := os.Getenv(key); value != "" {
+			return value, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not determine the locale of the host, LC_ALL and LANG are not set")
+}
+
+func getHostTimezone() (string, error) {
+	if tz := strings.TrimPrefix(os.Getenv("TZ"), ":"); tz != "" && timezoneRegex.MatchString(tz) {
+		return tz, nil
+	}
+
+	// /etc/localtime is a link into the zoneinfo database on most Unix systems
+	target, err := filepath.EvalSymlinks("/etc/localtime")
+	if err == nil {
+		if _, name, found := strings.Cut(filepath.ToSlash(target), "zoneinfo/"); found && timezoneRegex.MatchString(name) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not determine the timezone of the host, set TZ or pass a timezone name")
+}
@@ -33,54 +33,87 @@ const (
 	ResourceTypeImage     ResourceType = "images"
 )
 
+// Attempts to resume a log stream that broke off before giving up
+const maxLogReconnectAttempts = 5
+
+// ReadBuildLogs prints the build logs. If the connection breaks, the stream is resumed from the
+// offset of the bytes already printed so that no output is repeated.
 func ReadBuildLogs(ctx context.Context, params ReadLogParams) {
+	var offset int64
+	attempts := 0
+
+	for {
+		read, err := readBuildLogsFrom(ctx, params, offset)
+		offset += read
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+
+		if read > 0 {
+			attempts = 0
+		}
+
+		attempts++
+		if attempts > maxLogReconnectAttempts {
+			log.Errorf("%v", err)
+			return
+		}
+
+		log.Debugf("%v, reconnecting", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(attempts) * time.Second):
+		}
+	}
+}
+
+// readBuildLogsFrom prints the build logs starting at offset and returns the number of bytes printed.
+// A nil error means the stream ended and shouldn't be resumed.
+func readBuildLogsFrom(ctx context.Context, params ReadLogParams, offset int64) (int64, error) {
 	url := fmt.Sprintf("%s/%s/%s/build-logs", params.ServerUrl, params.ResourceType, params.Id)
 	if params.Follow != nil && *params.Follow {
-		url = fmt.Sprintf("%s?follow=true", url)
+		url = fmt.Sprintf("%s?follow=true&offset=%d", url, offset)
+	} else {
+		url = fmt.Sprintf("%s?offset=%d", url, offset)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		log.Errorf("Failed to create request: %v", err)
-		return
+		return 0, nil
 	}
 
-	if params.ServerApi.Key != nil {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", *params.ServerApi.Key))
-	} else if params.ServerApi.Token != nil {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", params.ServerApi.Token.AccessToken))
-
-		if params.ActiveOrganizationId != nil {
-			req.Header.Add("X-Daytona-Organization-ID", *params.ActiveOrganizationId)
-		}
-	}
+	AddAuthHeaders(req, params.ServerApi, params.ActiveOrganizationId)
 
 	req.Header.Add("Accept", "application/octet-stream")
 
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		log.Errorf("Failed to connect to server: %v", err)
-		return
+		return 0, fmt.Errorf("failed to connect to server: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		log.Errorf("Server returned a non-OK status while retrieving logs: %d", resp.StatusCode)
-		return
+		return 0, nil
 	}
 
 	reader := bufio.NewReader(resp.Body)
 	buffer := make([]byte, 4096)
 
+	var read int64
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return read, nil
 		default:
 			n, err := reader.Read(buffer)
 			if n > 0 {
 				fmt.Print(string(buffer[:n]))
+				read += int64(n)
 			}
 
 			if err != nil {
@@ -89,11 +122,23 @@ func ReadBuildLogs(ctx context.Context, params ReadLogParams) {
 						time.Sleep(500 * time.Millisecond)
 						continue
 					}
-					return
+					return read, nil
 				}
-				log.Errorf("Error reading from stream: %v", err)
-				return
+				return read, fmt.Errorf("error reading from stream: %w", err)
 			}
 		}
 	}
 }
+
+// AddAuthHeaders authenticates a request made to the server without the generated API client
+func AddAuthHeaders(req *http.Request, serverApi config.ServerApi, activeOrganizationId *string) {
+	if serverApi.Key != nil {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", *serverApi.Key))
+	} else if serverApi.Token != nil {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", serverApi.Token.AccessToken))
+
+		if activeOrganizationId != nil {
+			req.Header.Add("X-Daytona-Organization-ID", *activeOrganizationId)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var AttachCmd = &cobra.Command{
+	Use:   "attach [SANDBOX_ID]",
+	Short: "Attach to a persistent terminal session of a sandbox",
+	Long: `Attach to a persistent terminal session of a sandbox, starting it if it doesn't exist.
+
+The shell of the session keeps running when the terminal is closed or the connection drops,
+attaching again replays its recent output. Sessions are closed after being detached for 30 minutes.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		sandboxId := args[0]
+
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			return errors.New("attach requires an interactive terminal")
+		}
+
+		apiClient, err := apiclient.GetApiClient(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		preview, res, err := apiClient.WorkspaceAPI.GetPortPreviewUrl(ctx, sandboxId, SANDBOX_TERMINAL_PORT).Execute()
+		if err != nil {
+			return apiclient.HandleErrorResponse(res, err)
+		}
+
+		wsUrl, err := url.Parse(preview.Url)
+		if err != nil {
+			return err
+		}
+		switch wsUrl.Scheme {
+		case "https":
+			wsUrl.Scheme = "wss"
+		default:
+			wsUrl.Scheme = "ws"
+		}
+		wsUrl.Path = "/ws"
+		wsUrl.RawQuery = url.Values{"session": []string{attachSessionFlag}}.Encode()
+
+		header := http.Header{}
+		header.Set("X-Daytona-Preview-Token", preview.Token)
+
+		conn, res, err := websocket.DefaultDialer.DialContext(ctx, wsUrl.String(), header)
+		if err != nil {
+			if res != nil {
+				return fmt.Errorf("failed to attach to sandbox %s: %s", sandboxId, res.Status)
+			}
+			return fmt.Errorf("failed to attach to sandbox %s: %w", sandboxId, err)
+		}
+		defer conn.Close()
+
+		state, err := term.MakeRaw(int(os.Stdin.Fd()))
+		if err != nil {
+			return err
+		}
+		defer term.Restore(int(os.Stdin.Fd()), state)
+
+		return runAttachedTerminal(conn)
+	},
+}
+
+// runAttachedTerminal forwards stdin to the session and its output to stdout until the
+// session ends or the connection drops. The window size is sent whenever it changes.
+func runAttachedTerminal(conn *websocket.Conn) error {
+	// gorilla/websocket supports one concurrent writer, input and resizes share this channel
+	messages := make(chan attachMessage)
+	done := make(chan error, 1)
+
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				done <- nil
+				return
+			}
+			if _, err := os.Stdout.Write(data); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				return
+			}
+			messages <- attachMessage{messageType: websocket.BinaryMessage, data: append([]byte(nil), buf[:n]...)}
+		}
+	}()
+
+	go func() {
+		var lastWidth, lastHeight int
+		for {
+			width, height, err := term.GetSize(int(os.Stdout.Fd()))
+			if err == nil && (width != lastWidth || height != lastHeight) {
+				lastWidth, lastHeight = width, height
+				size, _ := json.Marshal(map[string]int{"rows": height, "cols": width})
+				messages <- attachMessage{messageType: websocket.TextMessage, data: size}
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case message := <-messages:
+			if err := conn.WriteMessage(message.messageType, message.data); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+type attachMessage struct {
+	messageType int
+	data        []byte
+}
+
+var attachSessionFlag string
+
+func init() {
+	AttachCmd.Flags().StringVar(&attachSessionFlag, "session", "default", "Name of the terminal session")
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	view_common "github.com/daytonaio/daytona/cli/views/common"
+	"github.com/daytonaio/daytona/daytonaapiclient"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+var CpCmd = &cobra.Command{
+	Use:   "cp [SOURCE] [DESTINATION]",
+	Short: "Copy files between the local machine and a sandbox",
+	Long: `Copy files or directories between the local machine and a sandbox.
+
+Use SANDBOX_ID:PATH to refer to a path inside a sandbox, e.g.:
+  daytona sandbox cp ./data my-sandbox:/home/daytona/data
+  daytona sandbox cp my-sandbox:/home/daytona/dist ./dist`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		srcSandboxId, srcPath, srcRemote := parseCopyTarget(args[0])
+		destSandboxId, destPath, destRemote := parseCopyTarget(args[1])
+
+		if srcRemote == destRemote {
+			return errors.New("exactly one of SOURCE and DESTINATION must be a sandbox path (SANDBOX_ID:PATH)")
+		}
+
+		apiClient, err := apiclient.GetApiClient(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var transferred uint64
+		if destRemote {
+			transferred, err = uploadPath(ctx, apiClient, destSandboxId, srcPath, destPath)
+		} else {
+			transferred, err = downloadPath(ctx, apiClient, srcSandboxId, srcPath, destPath)
+		}
+		if err != nil {
+			return err
+		}
+
+		view_common.RenderInfoMessageBold(fmt.Sprintf("Copied %s", humanize.IBytes(transferred)))
+		return nil
+	},
+}
+
+// parseCopyTarget splits a SANDBOX_ID:PATH argument. Arguments without a sandbox
+// prefix, or whose prefix looks like a local path, are treated as local paths.
+func parseCopyTarget(arg string) (string, string, bool) {
+	sandboxId, p, found := strings.Cut(arg, ":")
+	if !found || sandboxId == "" || strings.ContainsAny(sandboxId, `/\`) || filepath.VolumeName(arg) != "" {
+		return "", arg, false
+	}
+
+	return sandboxId, p, true
+}
+
+func uploadPath(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, src, dest string) (uint64, error) {
+	_, err := os.Stat(src)
+	if err != nil {
+		return 0, err
+	}
+
+	destInfo, res, err := apiClient.ToolboxAPI.GetFileInfo(ctx, sandboxId).Path(dest).Execute()
+	if err == nil && destInfo.IsDir {
+		dest = path.Join(dest, filepath.Base(src))
+	} else if err != nil && (res == nil || res.StatusCode != 404) {
+		return 0, apiclient.HandleErrorResponse(res, err)
+	}
+
+	var transferred uint64
+
+	err = filepath.WalkDir(src, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, localPath)
+		if err != nil {
+			return err
+		}
+
+		remotePath := dest
+		if rel != "." {
+			remotePath = path.Join(dest, filepath.ToSlash(rel))
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		mode := fmt.Sprintf("%04o", info.Mode().Perm())
+
+		if entry.IsDir() {
+			res, err := apiClient.ToolboxAPI.CreateFolder(ctx, sandboxId).Path(remotePath).Mode(mode).Execute()
+			if err != nil {
+				return apiclient.HandleErrorResponse(res, err)
+			}
+			return nil
+		}
+
+		if !info.Mode().IsRegular() {
+			fmt.Printf("Skipping %s: not a regular file\n", localPath)
+			return nil
+		}
+
+		err = uploadFile(ctx, apiClient, sandboxId, localPath, remotePath, mode)
+		if err != nil {
+			return err
+		}
+
+		transferred += uint64(info.Size())
+		fmt.Printf("%s -> %s:%s (%s)\n", localPath, sandboxId, remotePath, humanize.IBytes(uint64(info.Size())))
+
+		return nil
+	})
+
+	return transferred, err
+}
+
+// uploadFile uploads a single local file to remotePath and applies the given octal mode
+func uploadFile(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, localPath, remotePath, mode string) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	res, err := apiClient.ToolboxAPI.UploadFile(ctx, sandboxId).Path(remotePath).File(file).Execute()
+	if err != nil {
+		return apiclient.HandleErrorResponse(res, err)
+	}
+
+	res, err = apiClient.ToolboxAPI.SetFilePermissions(ctx, sandboxId).Path(remotePath).Mode(mode).Execute()
+	if err != nil {
+		return apiclient.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func downloadPath(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, src, dest string) (uint64, error) {
+	srcInfo, res, err := apiClient.ToolboxAPI.GetFileInfo(ctx, sandboxId).Path(src).Execute()
+	if err != nil {
+		return 0, apiclient.HandleErrorResponse(res, err)
+	}
+
+	if destInfo, err := os.Stat(dest); err == nil && destInfo.IsDir() {
+		dest = filepath.Join(dest, path.Base(src))
+	}
+
+	return downloadEntry(ctx, apiClient, sandboxId, src, dest, srcInfo)
+}
+
+func downloadEntry(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, src, dest string, info *daytonaapiclient.FileInfo) (uint64, error) {
+	mode := parseFileMode(info.Permissions)
+
+	if info.IsDir {
+		err := os.MkdirAll(dest, mode|0700)
+		if err != nil {
+			return 0, err
+		}
+
+		entries, res, err := apiClient.ToolboxAPI.ListFiles(ctx, sandboxId).Path(src).Execute()
+		if err != nil {
+			return 0, apiclient.HandleErrorResponse(res, err)
+		}
+
+		var transferred uint64
+		for _, entry := range entries {
+			n, err := downloadEntry(ctx, apiClient, sandboxId, path.Join(src, entry.Name), filepath.Join(dest, entry.Name), &entry)
+			transferred += n
+			if err != nil {
+				return transferred, err
+			}
+		}
+
+		return transferred, os.Chmod(dest, mode)
+	}
+
+	tmpFile, res, err := apiClient.ToolboxAPI.DownloadFile(ctx, sandboxId).Path(src).Execute()
+	if err != nil {
+		return 0, apiclient.HandleErrorResponse(res, err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	_, err = tmpFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, err
+	}
+
+	destFile, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return 0, err
+	}
+	defer destFile.Close()
+
+	n, err := io.Copy(destFile, tmpFile)
+	if err != nil {
+		return uint64(n), err
+	}
+
+	fmt.Printf("%s:%s -> %s (%s)\n", sandboxId, src, dest, humanize.IBytes(uint64(n)))
+
+	return uint64(n), os.Chmod(dest, mode)
+}
+
+func parseFileMode(permissions string) os.FileMode {
+	mode, err := strconv.ParseUint(permissions, 8, 32)
+	if err != nil {
+		return 0644
+	}
+
+	return os.FileMode(mode)
+}
@@ -5,8 +5,12 @@ package sandbox
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"os"
 	"strings"
 	"time"
 
@@ -16,15 +20,17 @@ import (
 	"github.com/daytonaio/daytona/cli/util"
 	views_common "github.com/daytonaio/daytona/cli/views/common"
 	daytonaapiclient "github.com/daytonaio/daytona/daytonaapiclient"
+	"github.com/distribution/reference"
+	"github.com/docker/go-units"
 	"github.com/spf13/cobra"
 )
 
 const SANDBOX_TERMINAL_PORT = 22222
 
 var CreateCmd = &cobra.Command{
-	Use:     "create [flags]",
+	Use:     "create [flags] [-- COMMAND [ARG...]]",
 	Short:   "Create a new sandbox",
-	Args:    cobra.NoArgs,
+	Args:    cobra.ArbitraryArgs,
 	Aliases: common.GetAliases("create"),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
@@ -34,32 +40,51 @@ var CreateCmd = &cobra.Command{
 			return err
 		}
 
+		// The command of the sandbox container follows --, like for docker run
+		if len(args) > 0 && cmd.ArgsLenAtDash() != 0 {
+			return fmt.Errorf("unexpected argument %q, the command of the sandbox must follow --", args[0])
+		}
+
+		if noStartFlag && gistFlag != "" {
+			return errors.New("--gist can't be used with --no-start, the gist files are uploaded to the running sandbox")
+		}
+
 		createWorkspace := daytonaapiclient.NewCreateWorkspace()
 
 		// Add non-zero values to the request
 		if imageFlag != "" {
+			err = validateImageDigest(imageFlag)
+			if err != nil {
+				return err
+			}
 			createWorkspace.SetImage(imageFlag)
 		}
 		if userFlag != "" {
 			createWorkspace.SetUser(userFlag)
 		}
-		if len(envFlag) > 0 {
-			env := make(map[string]string)
-			for _, e := range envFlag {
-				parts := strings.SplitN(e, "=", 2)
-				if len(parts) == 2 {
-					env[parts[0]] = parts[1]
-				}
+		env, err := common.ParseEnv(envFlag)
+		if err != nil {
+			return err
+		}
+		if tzFlag != "" {
+			env["TZ"], err = common.ResolveTimezone(tzFlag)
+			if err != nil {
+				return err
 			}
+		}
+		if localeFlag != "" {
+			env["LANG"], err = common.ResolveLocale(localeFlag)
+			if err != nil {
+				return err
+			}
+		}
+		if len(env) > 0 {
 			createWorkspace.SetEnv(env)
 		}
 		if len(labelsFlag) > 0 {
-			labels := make(map[string]string)
-			for _, l := range labelsFlag {
-				parts := strings.SplitN(l, "=", 2)
-				if len(parts) == 2 {
-					labels[parts[0]] = parts[1]
-				}
+			labels, err := common.ParseLabels(labelsFlag)
+			if err != nil {
+				return err
 			}
 			createWorkspace.SetLabels(labels)
 		}
@@ -87,6 +112,123 @@ var CreateCmd = &cobra.Command{
 		if autoStopFlag > 0 {
 			createWorkspace.SetAutoStopInterval(autoStopFlag)
 		}
+		if noStartFlag {
+			createWorkspace.SetNoStart(true)
+		}
+		if entrypointFlag != "" {
+			createWorkspace.SetEntrypoint([]string{entrypointFlag})
+		}
+		if len(args) > 0 {
+			createWorkspace.SetCmd(args)
+		}
+		if readinessPortFlag > 0 || readinessCommandFlag != "" {
+			readinessProbe := daytonaapiclient.NewReadinessProbe()
+			if readinessPortFlag > 0 {
+				readinessProbe.SetPort(readinessPortFlag)
+			}
+			if readinessCommandFlag != "" {
+				readinessProbe.SetCommand([]string{"sh", "-c", readinessCommandFlag})
+			}
+			if cmd.Flags().Changed("readiness-retries") {
+				readinessProbe.SetRetries(readinessRetriesFlag)
+			}
+			if cmd.Flags().Changed("readiness-interval") {
+				readinessProbe.SetIntervalSeconds(readinessIntervalFlag)
+			}
+			if cmd.Flags().Changed("readiness-timeout") {
+				readinessProbe.SetTimeoutSeconds(readinessTimeoutFlag)
+			}
+			createWorkspace.SetReadinessProbe(*readinessProbe)
+		}
+		if len(dnsFlag) > 0 {
+			createWorkspace.SetDns(dnsFlag)
+		}
+		if len(addHostFlag) > 0 {
+			createWorkspace.SetExtraHosts(addHostFlag)
+		}
+		if readOnlyFlag {
+			createWorkspace.SetReadonlyRootfs(true)
+		}
+		if len(tmpfsFlag) > 0 {
+			createWorkspace.SetTmpfs(tmpfsFlag)
+		}
+		if seccompProfileFlag != "" || appArmorProfileFlag != "" || len(capAddFlag) > 0 || len(capDropFlag) > 0 {
+			security := daytonaapiclient.NewWorkspaceSecurity()
+			if seccompProfileFlag != "" {
+				security.SetSeccompProfile(seccompProfileFlag)
+			}
+			if appArmorProfileFlag != "" {
+				security.SetAppArmorProfile(appArmorProfileFlag)
+			}
+			if len(capAddFlag) > 0 {
+				security.SetCapAdd(capAddFlag)
+			}
+			if len(capDropFlag) > 0 {
+				security.SetCapDrop(capDropFlag)
+			}
+			createWorkspace.SetSecurity(*security)
+		}
+		if cmd.Flags().Changed("privileged") {
+			createWorkspace.SetPrivileged(privilegedFlag)
+		}
+		if mountDockerSocketFlag {
+			createWorkspace.SetMountDockerSocket(true)
+		}
+		if len(ulimitFlag) > 0 {
+			ulimits, err := parseUlimits(ulimitFlag)
+			if err != nil {
+				return err
+			}
+			createWorkspace.SetUlimits(ulimits)
+		}
+		if len(initStepFlag) > 0 {
+			initSteps := make([]daytonaapiclient.WorkspaceInitStep, 0, len(initStepFlag))
+			for _, step := range initStepFlag {
+				image, command, found := strings.Cut(step, "=")
+				if image == "" {
+					return fmt.Errorf("invalid init step %s: expected format IMAGE[=COMMAND]", step)
+				}
+				initStep := daytonaapiclient.NewWorkspaceInitStep(image)
+				if found && command != "" {
+					initStep.SetCommand([]string{"sh", "-c", command})
+				}
+				initSteps = append(initSteps, *initStep)
+			}
+			createWorkspace.SetInitSteps(initSteps)
+		}
+		if len(fileFlag) > 0 {
+			files, err := readSandboxFiles(fileFlag)
+			if err != nil {
+				return err
+			}
+			createWorkspace.SetFiles(files)
+		}
+		if hostnameFlag != "" {
+			createWorkspace.SetHostname(hostnameFlag)
+		}
+		if domainnameFlag != "" {
+			createWorkspace.SetDomainname(domainnameFlag)
+		}
+		if cmd.Flags().Changed("stop-grace-period") {
+			if stopGracePeriodFlag < 0 {
+				return errors.New("stop grace period must not be negative")
+			}
+			createWorkspace.SetStopGracePeriodSeconds(int32(stopGracePeriodFlag / time.Second))
+		}
+		if pullFlag != "" {
+			switch pullFlag {
+			case "always", "if-not-present", "never":
+				createWorkspace.SetImagePullPolicy(pullFlag)
+			default:
+				return fmt.Errorf("invalid pull policy %s: expected always, if-not-present or never", pullFlag)
+			}
+		}
+		if len(postCreateFlag) > 0 {
+			createWorkspace.SetPostCreateCommands(postCreateFlag)
+		}
+		if postCreateContinueOnErrorFlag {
+			createWorkspace.SetPostCreateContinueOnError(true)
+		}
 		if dockerfileFlag != "" {
 			createBuildInfoDto, err := common.GetCreateBuildInfoDto(ctx, dockerfileFlag, contextFlag)
 			if err != nil {
@@ -95,6 +237,23 @@ var CreateCmd = &cobra.Command{
 			createWorkspace.SetBuildInfo(*createBuildInfoDto)
 		}
 
+		var gist *common.Gist
+		if gistFlag != "" {
+			gist, err = common.FetchGist(ctx, gistFlag)
+			if err != nil {
+				return err
+			}
+
+			// Without an explicit image, the sandbox is built from a base image for the gist's language
+			if imageFlag == "" && dockerfileFlag == "" {
+				if gistImage := common.GetGistImage(gist); gistImage != "" {
+					createWorkspace.SetBuildInfo(daytonaapiclient.CreateBuildInfo{
+						DockerfileContent: fmt.Sprintf("FROM %s\n", gistImage),
+					})
+				}
+			}
+		}
+
 		if len(volumesFlag) > 0 {
 			volumes := make([]daytonaapiclient.WorkspaceVolume, 0, len(volumesFlag))
 			for _, v := range volumesFlag {
@@ -149,7 +308,13 @@ var CreateCmd = &cobra.Command{
 				ResourceType:         common.ResourceTypeWorkspace,
 			})
 
-			err = common.AwaitSandboxState(ctx, apiClient, workspace.Id, daytonaapiclient.WORKSPACESTATE_STARTED)
+			// Sandboxes created without starting are done once their container is created
+			awaitedState := daytonaapiclient.WORKSPACESTATE_STARTED
+			if noStartFlag {
+				awaitedState = daytonaapiclient.WORKSPACESTATE_STOPPED
+			}
+
+			err = common.AwaitSandboxState(ctx, apiClient, workspace.Id, awaitedState)
 			if err != nil {
 				return err
 			}
@@ -159,6 +324,18 @@ var CreateCmd = &cobra.Command{
 			stopLogs()
 		}
 
+		if gist != nil {
+			err = uploadGist(ctx, apiClient, workspace.Id, gist)
+			if err != nil {
+				return err
+			}
+		}
+
+		if noStartFlag {
+			views_common.RenderInfoMessageBold(fmt.Sprintf("Sandbox %s created, start it with 'daytona sandbox start %s'", workspace.Id, workspace.Id))
+			return nil
+		}
+
 		var nodeDomain string
 		if workspace.Info != nil && workspace.Info.ProviderMetadata != nil {
 			metadata := make(map[string]interface{})
@@ -192,12 +369,46 @@ var (
 	volumesFlag    []string
 	dockerfileFlag string
 	contextFlag    []string
+	gistFlag       string
+	tzFlag         string
+	localeFlag     string
+	noStartFlag    bool
+	entrypointFlag string
+
+	readinessPortFlag     int32
+	readinessCommandFlag  string
+	readinessRetriesFlag  int32
+	readinessIntervalFlag int32
+	readinessTimeoutFlag  int32
+
+	dnsFlag      []string
+	addHostFlag  []string
+	readOnlyFlag bool
+	tmpfsFlag    []string
+
+	seccompProfileFlag  string
+	appArmorProfileFlag string
+	capAddFlag          []string
+	capDropFlag         []string
+
+	privilegedFlag        bool
+	mountDockerSocketFlag bool
+	ulimitFlag            []string
+	initStepFlag          []string
+	fileFlag              []string
+	hostnameFlag          string
+	domainnameFlag        string
+	stopGracePeriodFlag   time.Duration
+	pullFlag              string
+
+	postCreateFlag                []string
+	postCreateContinueOnErrorFlag bool
 )
 
 func init() {
 	CreateCmd.Flags().StringVar(&imageFlag, "image", "", "Image to use for the sandbox")
 	CreateCmd.Flags().StringVar(&userFlag, "user", "", "User associated with the sandbox")
-	CreateCmd.Flags().StringArrayVarP(&envFlag, "env", "e", []string{}, "Environment variables (format: KEY=VALUE)")
+	CreateCmd.Flags().StringArrayVarP(&envFlag, "env", "e", []string{}, "Environment variables (format: KEY=VALUE, or KEY=@FILE to read the value from a file)")
 	CreateCmd.Flags().StringArrayVarP(&labelsFlag, "label", "l", []string{}, "Labels (format: KEY=VALUE)")
 	CreateCmd.Flags().BoolVar(&publicFlag, "public", false, "Make sandbox publicly accessible")
 	CreateCmd.Flags().StringVar(&classFlag, "class", "", "Workspace class type (small, medium, large)")
@@ -210,4 +421,95 @@ func init() {
 	CreateCmd.Flags().StringArrayVarP(&volumesFlag, "volume", "v", []string{}, "Volumes to mount (format: VOLUME_NAME:MOUNT_PATH)")
 	CreateCmd.Flags().StringVarP(&dockerfileFlag, "dockerfile", "f", "", "Path to Dockerfile for Sandbox image")
 	CreateCmd.Flags().StringArrayVarP(&contextFlag, "context", "c", []string{}, "Files or directories to include in the build context (can be specified multiple times)")
+	CreateCmd.Flags().StringVar(&tzFlag, "tz", "", "Timezone of the sandbox, e.g. Europe/Berlin, or local for the timezone of this machine")
+	CreateCmd.Flags().StringVar(&localeFlag, "locale", "", "Locale of the sandbox, e.g. en_US.UTF-8, or local for the locale of this machine")
+	CreateCmd.Flags().StringVar(&gistFlag, "gist", "", "GitHub gist ID or URL whose files are added to the project directory of the sandbox")
+	CreateCmd.Flags().BoolVar(&noStartFlag, "no-start", false, "Create the sandbox without starting it")
+	CreateCmd.Flags().StringVar(&entrypointFlag, "entrypoint", "", "Entrypoint of the sandbox container, overrides the entrypoint of the image")
+	CreateCmd.Flags().Int32Var(&readinessPortFlag, "readiness-port", 0, "Port that must accept connections before the sandbox is reported as started")
+	CreateCmd.Flags().StringVar(&readinessCommandFlag, "readiness-command", "", "Command that must succeed before the sandbox is reported as started")
+	CreateCmd.Flags().Int32Var(&readinessRetriesFlag, "readiness-retries", 30, "Number of readiness checks before the sandbox start fails")
+	CreateCmd.Flags().Int32Var(&readinessIntervalFlag, "readiness-interval", 1, "Delay between readiness checks in seconds")
+	CreateCmd.Flags().Int32Var(&readinessTimeoutFlag, "readiness-timeout", 1, "Timeout of a single readiness check in seconds")
+	CreateCmd.Flags().StringArrayVar(&dnsFlag, "dns", []string{}, "Custom DNS servers of the sandbox (can be specified multiple times)")
+	CreateCmd.Flags().StringArrayVar(&addHostFlag, "add-host", []string{}, "Additional host-to-IP mappings of the sandbox (format: HOST:IP)")
+	CreateCmd.Flags().BoolVar(&readOnlyFlag, "read-only", false, "Mount the root filesystem of the sandbox read-only, /tmp, /run and the home directory stay writable")
+	CreateCmd.Flags().StringArrayVar(&tmpfsFlag, "tmpfs", []string{}, "Additional tmpfs mounts of the sandbox (format: PATH[:OPTIONS])")
+	CreateCmd.Flags().StringVar(&seccompProfileFlag, "seccomp-profile", "", "Path of a seccomp profile on the runner host, or unconfined")
+	CreateCmd.Flags().StringVar(&appArmorProfileFlag, "apparmor-profile", "", "Name of a loaded AppArmor profile, or unconfined")
+	CreateCmd.Flags().StringArrayVar(&capAddFlag, "cap-add", []string{}, "Linux capabilities to add to the sandbox, e.g. SYS_PTRACE")
+	CreateCmd.Flags().StringArrayVar(&capDropFlag, "cap-drop", []string{}, "Linux capabilities to drop from the sandbox, e.g. NET_RAW")
+	CreateCmd.Flags().BoolVar(&privilegedFlag, "privileged", false, "Run the sandbox privileged, e.g. for Docker-in-Docker. Defaults to the policy of the runner")
+	CreateCmd.Flags().BoolVar(&mountDockerSocketFlag, "mount-docker-socket", false, "Mount the Docker socket of the runner host into the sandbox")
+	CreateCmd.Flags().StringArrayVar(&ulimitFlag, "ulimit", []string{}, "Resource limits of the sandbox processes (format: NAME=SOFT[:HARD], e.g. nofile=1024:2048)")
+	CreateCmd.Flags().StringArrayVar(&initStepFlag, "init-step", []string{}, "Step run to completion before the sandbox first starts, in the order given (format: IMAGE[=SHELL_COMMAND])")
+	CreateCmd.Flags().StringArrayVar(&fileFlag, "file", []string{}, "Local file written into the sandbox before it first starts (format: LOCAL_PATH:SANDBOX_PATH)")
+	CreateCmd.Flags().StringVar(&hostnameFlag, "hostname", "", "Hostname of the sandbox, defaults to the sandbox ID")
+	CreateCmd.Flags().StringVar(&domainnameFlag, "domainname", "", "Domain name of the sandbox")
+	CreateCmd.Flags().DurationVar(&stopGracePeriodFlag, "stop-grace-period", 0, "Time the sandbox gets to shut down when it is stopped before it is killed, e.g. 60s")
+	CreateCmd.Flags().StringVar(&pullFlag, "pull", "", "Image pull policy of the sandbox (always, if-not-present, never)")
+	CreateCmd.Flags().StringArrayVar(&postCreateFlag, "post-create", []string{}, "Shell command run inside the sandbox after it first starts, in the order given")
+	CreateCmd.Flags().BoolVar(&postCreateContinueOnErrorFlag, "post-create-continue-on-error", false, "Keep the sandbox when a post-create command fails")
+}
+
+// validateImageDigest checks the digest of images pinned with image@sha256:<digest>
+func validateImageDigest(image string) error {
+	if !strings.Contains(image, "@") {
+		return nil
+	}
+
+	if _, err := reference.Parse(image); err != nil {
+		return fmt.Errorf("invalid image digest in %s: %w", image, err)
+	}
+
+	return nil
+}
+
+// parseUlimits parses ulimits in the format of docker run --ulimit
+func parseUlimits(args []string) ([]daytonaapiclient.WorkspaceUlimit, error) {
+	ulimits := make([]daytonaapiclient.WorkspaceUlimit, 0, len(args))
+	for _, arg := range args {
+		ulimit, err := units.ParseUlimit(arg)
+		if err != nil {
+			return nil, err
+		}
+		if ulimit.Soft < 0 || ulimit.Hard < 0 || ulimit.Hard > math.MaxInt32 {
+			return nil, fmt.Errorf("invalid ulimit %s: limits must be between 0 and %d", arg, math.MaxInt32)
+		}
+
+		ulimits = append(ulimits, *daytonaapiclient.NewWorkspaceUlimit(ulimit.Name, int32(ulimit.Soft), int32(ulimit.Hard)))
+	}
+
+	return ulimits, nil
+}
+
+// readSandboxFiles reads the local files to write into the sandbox, keeping their permission bits
+func readSandboxFiles(args []string) ([]daytonaapiclient.WorkspaceFile, error) {
+	files := make([]daytonaapiclient.WorkspaceFile, 0, len(args))
+	for _, arg := range args {
+		sep := strings.LastIndex(arg, ":")
+		if sep <= 0 || !strings.HasPrefix(arg[sep+1:], "/") {
+			return nil, fmt.Errorf("invalid file %s: expected format LOCAL_PATH:SANDBOX_PATH with an absolute sandbox path", arg)
+		}
+
+		localPath, sandboxPath := arg[:sep], arg[sep+1:]
+		info, err := os.Stat(localPath)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			return nil, fmt.Errorf("invalid file %s: %s is a directory", arg, localPath)
+		}
+
+		content, err := os.ReadFile(localPath)
+		if err != nil {
+			return nil, err
+		}
+
+		file := daytonaapiclient.NewWorkspaceFile(sandboxPath, base64.StdEncoding.EncodeToString(content))
+		file.SetMode(int32(info.Mode().Perm()))
+		files = append(files, *file)
+	}
+
+	return files, nil
 }
@@ -5,11 +5,14 @@ package sandbox
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cli/apiclient"
 	"github.com/daytonaio/daytona/cli/cmd/common"
 	view_common "github.com/daytonaio/daytona/cli/views/common"
+	view_sandbox "github.com/daytonaio/daytona/cli/views/sandbox"
+	"github.com/daytonaio/daytona/daytonaapiclient"
 	"github.com/spf13/cobra"
 )
 
@@ -33,6 +36,11 @@ var DeleteCmd = &cobra.Command{
 
 		if len(args) == 0 {
 			if allFlag {
+				if dryRunFlag {
+					renderDeletePlan(ctx, apiClient, sandboxList)
+					return nil
+				}
+
 				var deletedCount int
 
 				for _, w := range sandboxList {
@@ -52,19 +60,24 @@ var DeleteCmd = &cobra.Command{
 
 		deletionArg := args[0]
 
-		var sandboxCount int
+		var matchedSandboxes []daytonaapiclient.Workspace
 
 		for _, w := range sandboxList {
 			if w.Id == args[0] {
 				deletionArg = w.Id
-				sandboxCount++
+				matchedSandboxes = append(matchedSandboxes, w)
 			}
 		}
 
-		switch sandboxCount {
+		switch len(matchedSandboxes) {
 		case 0:
 			return fmt.Errorf("sandbox %s not found", args[0])
 		case 1:
+			if dryRunFlag {
+				renderDeletePlan(ctx, apiClient, matchedSandboxes)
+				return nil
+			}
+
 			res, err := apiClient.WorkspaceAPI.DeleteWorkspace(ctx, deletionArg).Force(forceFlag).Execute()
 			if err != nil {
 				return apiclient.HandleErrorResponse(res, err)
@@ -80,8 +93,57 @@ var DeleteCmd = &cobra.Command{
 }
 
 var forceFlag bool
+var dryRunFlag bool
 
 func init() {
 	DeleteCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Delete all sandboxes")
 	DeleteCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "Force delete")
+	DeleteCmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "Show what would be deleted and warn about uncommitted changes without deleting")
+}
+
+func renderDeletePlan(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxes []daytonaapiclient.Workspace) {
+	plans := make([]view_sandbox.DeletePlan, 0, len(sandboxes))
+
+	for _, sandbox := range sandboxes {
+		plan := view_sandbox.DeletePlan{
+			Sandbox: sandbox,
+		}
+
+		if sandbox.State == nil || *sandbox.State != daytonaapiclient.WORKSPACESTATE_STARTED {
+			plan.GitStatusError = "sandbox is not started"
+		} else {
+			gitStatus, err := getDeletePlanGitStatus(ctx, apiClient, sandbox.Id)
+			if err != nil {
+				plan.GitStatusError = err.Error()
+			} else {
+				plan.GitStatus = gitStatus
+			}
+		}
+
+		plans = append(plans, plan)
+	}
+
+	view_sandbox.RenderDeletePlan(plans)
+}
+
+func getDeletePlanGitStatus(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId string) (*view_sandbox.DeletePlanGitStatus, error) {
+	projectDir, res, err := apiClient.ToolboxAPI.GetProjectDir(ctx, sandboxId).Execute()
+	if err != nil {
+		return nil, apiclient.HandleErrorResponse(res, err)
+	}
+
+	if projectDir.Dir == nil {
+		return nil, errors.New("project directory is unknown")
+	}
+
+	status, err := getGitStatus(ctx, apiClient, sandboxId, *projectDir.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &view_sandbox.DeletePlanGitStatus{
+		ProjectDir:         *projectDir.Dir,
+		UncommittedChanges: len(status.FileStatus),
+		UnpushedCommits:    status.Ahead,
+	}, nil
 }
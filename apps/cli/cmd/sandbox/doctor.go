@@ -0,0 +1,232 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	"github.com/daytonaio/daytona/cli/views/sandbox"
+	"github.com/daytonaio/daytona/daytonaapiclient"
+	"github.com/spf13/cobra"
+)
+
+const (
+	diskUsageWarningPercent  = 90
+	diskUsageCriticalPercent = 98
+)
+
+var DoctorCmd = &cobra.Command{
+	Use:   "doctor [SANDBOX_ID]",
+	Short: "Run diagnostics on a sandbox",
+	Long:  "Check the state, toolbox, disk space and git repository of a sandbox and print a report with remediation hints",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		sandboxId := args[0]
+
+		apiClient, err := apiclient.GetApiClient(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		checks := runDoctorChecks(ctx, apiClient, sandboxId)
+
+		sandbox.RenderDoctorReport(sandboxId, checks)
+
+		for _, check := range checks {
+			if check.Status == sandbox.DoctorCheckFailed {
+				return fmt.Errorf("one or more critical checks failed")
+			}
+		}
+
+		return nil
+	},
+}
+
+// runDoctorChecks runs the diagnostics in order. Checks that need a running sandbox
+// are skipped once the sandbox or its toolbox turn out to be unavailable.
+func runDoctorChecks(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId string) []sandbox.DoctorCheck {
+	checks := []sandbox.DoctorCheck{}
+
+	stateCheck, running := checkSandboxState(ctx, apiClient, sandboxId)
+	checks = append(checks, stateCheck)
+
+	if !running {
+		return append(checks,
+			skippedCheck("Toolbox"),
+			skippedCheck("Disk space"),
+			skippedCheck("Git repository"),
+		)
+	}
+
+	toolboxCheck, projectDir := checkToolbox(ctx, apiClient, sandboxId)
+	checks = append(checks, toolboxCheck)
+
+	if projectDir == "" {
+		return append(checks,
+			skippedCheck("Disk space"),
+			skippedCheck("Git repository"),
+		)
+	}
+
+	return append(checks,
+		checkDiskSpace(ctx, apiClient, sandboxId, projectDir),
+		checkGitRepository(ctx, apiClient, sandboxId, projectDir),
+	)
+}
+
+func checkSandboxState(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId string) (sandbox.DoctorCheck, bool) {
+	check := sandbox.DoctorCheck{Name: "State"}
+
+	sb, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, sandboxId).Execute()
+	if err != nil {
+		check.Status = sandbox.DoctorCheckFailed
+		check.Message = apiclient.HandleErrorResponse(res, err).Error()
+		check.Hint = "Make sure the sandbox ID is correct and that it belongs to the active organization"
+		return check, false
+	}
+
+	if sb.State == nil {
+		check.Status = sandbox.DoctorCheckFailed
+		check.Message = "sandbox state is unknown"
+		return check, false
+	}
+
+	switch *sb.State {
+	case daytonaapiclient.WORKSPACESTATE_STARTED:
+		check.Status = sandbox.DoctorCheckPassed
+		check.Message = "sandbox is running"
+		if sb.Image != nil {
+			check.Message = fmt.Sprintf("sandbox is running image %s", *sb.Image)
+		}
+		return check, true
+	case daytonaapiclient.WORKSPACESTATE_ERROR:
+		check.Status = sandbox.DoctorCheckFailed
+		check.Message = "sandbox is in an error state"
+		if sb.ErrorReason != nil {
+			check.Message = fmt.Sprintf("sandbox is in an error state: %s", *sb.ErrorReason)
+		}
+		check.Hint = fmt.Sprintf("Try restarting it with 'daytona sandbox stop %s' and 'daytona sandbox start %s'", sandboxId, sandboxId)
+	default:
+		check.Status = sandbox.DoctorCheckFailed
+		check.Message = fmt.Sprintf("sandbox is %s", *sb.State)
+		check.Hint = fmt.Sprintf("Start it with 'daytona sandbox start %s'", sandboxId)
+	}
+
+	return check, false
+}
+
+func checkToolbox(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId string) (sandbox.DoctorCheck, string) {
+	check := sandbox.DoctorCheck{Name: "Toolbox"}
+
+	projectDir, res, err := apiClient.ToolboxAPI.GetProjectDir(ctx, sandboxId).Execute()
+	if err != nil {
+		check.Status = sandbox.DoctorCheckFailed
+		check.Message = fmt.Sprintf("toolbox is not reachable: %s", apiclient.HandleErrorResponse(res, err))
+		check.Hint = "The daemon inside the sandbox may have crashed, restarting the sandbox starts it again"
+		return check, ""
+	}
+
+	check.Status = sandbox.DoctorCheckPassed
+	check.Message = "toolbox is reachable"
+
+	return check, projectDir.GetDir()
+}
+
+func checkDiskSpace(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, projectDir string) sandbox.DoctorCheck {
+	check := sandbox.DoctorCheck{Name: "Disk space"}
+
+	response, res, err := apiClient.ToolboxAPI.ExecuteCommand(ctx, sandboxId).ExecuteRequest(daytonaapiclient.ExecuteRequest{
+		Command: fmt.Sprintf("df -P %s", quoteCommandArg(projectDir)),
+	}).Execute()
+	if err != nil {
+		check.Status = sandbox.DoctorCheckWarning
+		check.Message = fmt.Sprintf("failed to check disk usage: %s", apiclient.HandleErrorResponse(res, err))
+		return check
+	}
+
+	usage, err := parseDiskUsagePercent(response.Result)
+	if err != nil || response.ExitCode != 0 {
+		check.Status = sandbox.DoctorCheckWarning
+		check.Message = "failed to parse disk usage"
+		return check
+	}
+
+	check.Message = fmt.Sprintf("%d%% of the disk is used", usage)
+
+	switch {
+	case usage >= diskUsageCriticalPercent:
+		check.Status = sandbox.DoctorCheckFailed
+		check.Hint = "Free up space in the sandbox or recreate it with a larger --disk"
+	case usage >= diskUsageWarningPercent:
+		check.Status = sandbox.DoctorCheckWarning
+		check.Hint = "The sandbox is running low on disk space"
+	default:
+		check.Status = sandbox.DoctorCheckPassed
+	}
+
+	return check
+}
+
+// parseDiskUsagePercent reads the capacity column of POSIX df output
+func parseDiskUsagePercent(output string) (int, error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) < 2 {
+		return 0, fmt.Errorf("unexpected df output")
+	}
+
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 5 {
+		return 0, fmt.Errorf("unexpected df output")
+	}
+
+	return strconv.Atoi(strings.TrimSuffix(fields[4], "%"))
+}
+
+func checkGitRepository(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, projectDir string) sandbox.DoctorCheck {
+	check := sandbox.DoctorCheck{Name: "Git repository"}
+
+	status, err := getGitStatus(ctx, apiClient, sandboxId, projectDir)
+	if err != nil {
+		if strings.Contains(err.Error(), notGitRepositoryMessage) {
+			check.Status = sandbox.DoctorCheckSkipped
+			check.Message = fmt.Sprintf("%s is not a git repository", projectDir)
+			return check
+		}
+
+		check.Status = sandbox.DoctorCheckWarning
+		check.Message = fmt.Sprintf("failed to get the git status: %s", err)
+		return check
+	}
+
+	if len(status.FileStatus) > 0 {
+		check.Status = sandbox.DoctorCheckWarning
+		check.Message = fmt.Sprintf("%d uncommitted change(s) on branch %s", len(status.FileStatus), status.CurrentBranch)
+		check.Hint = "Commit or stash the changes before deleting the sandbox"
+		return check
+	}
+
+	check.Status = sandbox.DoctorCheckPassed
+	check.Message = fmt.Sprintf("working tree on branch %s is clean", status.CurrentBranch)
+
+	return check
+}
+
+// quoteCommandArg single-quotes an argument of a toolbox command, which the toolbox splits on spaces
+// outside of quotes
+func quoteCommandArg(arg string) string {
+	return "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+}
+
+func skippedCheck(name string) sandbox.DoctorCheck {
+	return sandbox.DoctorCheck{
+		Name:    name,
+		Status:  sandbox.DoctorCheckSkipped,
+		Message: "skipped",
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	"github.com/daytonaio/daytona/cli/cmd/common"
+	"github.com/daytonaio/daytona/cli/config"
+	view_sandbox "github.com/daytonaio/daytona/cli/views/sandbox"
+	"github.com/spf13/cobra"
+)
+
+var EventsCmd = &cobra.Command{
+	Use:   "events [SANDBOX_ID]",
+	Short: "Stream sandbox events",
+	Long: `Stream events of the sandbox container, like out of memory kills, exits and restarts, until interrupted.
+
+Recent events are shown first, use --since to choose how far back to look.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if eventsSinceFlag < 0 {
+			return fmt.Errorf("since must not be negative")
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		eventsUrl := fmt.Sprintf("%s/workspace/%s/events", activeProfile.Api.Url, url.PathEscape(args[0]))
+		if eventsSinceFlag > 0 {
+			eventsUrl = fmt.Sprintf("%s?since=%s", eventsUrl, url.QueryEscape(eventsSinceFlag.String()))
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", eventsUrl, nil)
+		if err != nil {
+			return err
+		}
+
+		common.AddAuthHeaders(req, activeProfile.Api, activeProfile.ActiveOrganizationId)
+		req.Header.Add("Accept", "application/x-ndjson")
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			return apiclient.HandleErrorResponse(res, fmt.Errorf("failed to stream events: %s", res.Status))
+		}
+
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			var event view_sandbox.Event
+			err := json.Unmarshal(scanner.Bytes(), &event)
+			if err != nil {
+				return fmt.Errorf("failed to parse event: %w", err)
+			}
+
+			view_sandbox.RenderEvent(event)
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return scanner.Err()
+	},
+}
+
+var eventsSinceFlag time.Duration
+
+func init() {
+	EventsCmd.Flags().DurationVar(&eventsSinceFlag, "since", time.Hour, "Show the events of this duration before now first")
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	"github.com/daytonaio/daytona/cli/cmd/common"
+	"github.com/daytonaio/daytona/daytonaapiclient"
+)
+
+// uploadGist waits for the sandbox to start and writes the gist's files to its project directory
+func uploadGist(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId string, gist *common.Gist) error {
+	err := common.AwaitSandboxState(ctx, apiClient, sandboxId, daytonaapiclient.WORKSPACESTATE_STARTED)
+	if err != nil {
+		return err
+	}
+
+	projectDir, res, err := apiClient.ToolboxAPI.GetProjectDir(ctx, sandboxId).Execute()
+	if err != nil {
+		return apiclient.HandleErrorResponse(res, err)
+	}
+
+	if projectDir.Dir == nil {
+		return errors.New("failed to get the project directory of the sandbox")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "daytona-gist-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for name, file := range gist.Files {
+		localPath := filepath.Join(tmpDir, name)
+		err = os.WriteFile(localPath, []byte(file.Content), 0644)
+		if err != nil {
+			return err
+		}
+
+		remotePath := path.Join(*projectDir.Dir, name)
+		err = uploadFile(ctx, apiClient, sandboxId, localPath, remotePath, "0644")
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", name, err)
+		}
+	}
+
+	fmt.Printf("Added %d files from gist %s to %s\n", len(gist.Files), gist.Id, *projectDir.Dir)
+
+	return nil
+}
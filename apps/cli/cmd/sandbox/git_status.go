@@ -0,0 +1,45 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	daytonaapiclient "github.com/daytonaio/daytona/daytonaapiclient"
+)
+
+// notGitRepositoryMessage is part of the toolbox error for paths outside of a git repository
+const notGitRepositoryMessage = "repository does not exist"
+
+type sandboxGitStatus struct {
+	CurrentBranch string            `json:"currentBranch"`
+	FileStatus    []json.RawMessage `json:"fileStatus"`
+	Ahead         int               `json:"ahead"`
+}
+
+// getGitStatus returns the git status of a path in the sandbox. The generated GitStatus model expects
+// the files under FileStatus[] while the toolbox returns them as fileStatus, so the raw response body
+// is decoded instead of the model.
+func getGitStatus(ctx context.Context, apiClient *daytonaapiclient.APIClient, sandboxId, path string) (*sandboxGitStatus, error) {
+	_, res, err := apiClient.ToolboxAPI.GitGetStatus(ctx, sandboxId).Path(path).Execute()
+	if res == nil || res.StatusCode >= 300 {
+		return nil, apiclient.HandleErrorResponse(res, err)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var status sandboxGitStatus
+	err = json.Unmarshal(body, &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
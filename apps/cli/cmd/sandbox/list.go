@@ -5,6 +5,8 @@ package sandbox
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/daytonaio/daytona/cli/apiclient"
 	"github.com/daytonaio/daytona/cli/cmd/common"
@@ -17,6 +19,9 @@ var (
 	verboseFlag bool
 	pageFlag    int
 	limitFlag   int
+	sortFlag    string
+	orderFlag   string
+	searchFlag  string
 )
 
 var ListCmd = &cobra.Command{
@@ -27,6 +32,19 @@ var ListCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
+		// An order on its own sorts by creation time, e.g. --order desc lists the newest sandboxes first
+		if sortFlag == "" && cmd.Flags().Changed("order") {
+			sortFlag = string(sandbox.SortFieldCreated)
+		}
+
+		if sortFlag != "" && !slices.Contains(sandbox.SortFields, sandbox.SortField(sortFlag)) {
+			return fmt.Errorf("invalid sort field %q, must be one of %v", sortFlag, sandbox.SortFields)
+		}
+
+		if orderFlag != "asc" && orderFlag != "desc" {
+			return fmt.Errorf("invalid order %q, must be one of [asc desc]", orderFlag)
+		}
+
 		apiClient, err := apiclient.GetApiClient(nil, nil)
 		if err != nil {
 			return err
@@ -37,7 +55,15 @@ var ListCmd = &cobra.Command{
 			return apiclient.HandleErrorResponse(res, err)
 		}
 
-		sandbox.SortSandboxes(&sandboxList)
+		if searchFlag != "" {
+			sandboxList = sandbox.FilterSandboxes(sandboxList, searchFlag)
+		}
+
+		if sortFlag != "" {
+			sandbox.SortSandboxesBy(&sandboxList, sandbox.SortField(sortFlag), orderFlag == "desc")
+		} else {
+			sandbox.SortSandboxes(&sandboxList)
+		}
 
 		start := (pageFlag - 1) * limitFlag
 		end := start + limitFlag
@@ -74,5 +100,8 @@ func init() {
 	ListCmd.Flags().BoolVarP(&verboseFlag, "verbose", "v", false, "Include verbose output")
 	ListCmd.Flags().IntVarP(&pageFlag, "page", "p", 1, "Page number for pagination (starting from 1)")
 	ListCmd.Flags().IntVarP(&limitFlag, "limit", "l", 100, "Maximum number of items per page")
+	ListCmd.Flags().StringVar(&sortFlag, "sort", "", "Field to sort by (id, name, created, last-event, state). State sorts in lifecycle order, from building to destroyed")
+	ListCmd.Flags().StringVar(&orderFlag, "order", "asc", "Sort order (asc, desc). Sorts by created when --sort is not set")
+	ListCmd.Flags().StringVarP(&searchFlag, "search", "s", "", "Only list sandboxes whose ID, name, image or label values contain the search term")
 	common.RegisterFormatFlag(ListCmd)
 }
@@ -0,0 +1,52 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	"github.com/daytonaio/daytona/cli/cmd/common"
+	view_common "github.com/daytonaio/daytona/cli/views/common"
+	daytonaapiclient "github.com/daytonaio/daytona/daytonaapiclient"
+	"github.com/spf13/cobra"
+)
+
+var RebuildCmd = &cobra.Command{
+	Use:   "rebuild [SANDBOX_ID]",
+	Short: "Rebuild the image of a sandbox and recreate it",
+	Long:  "Rebuild the image of a sandbox created from a Dockerfile and recreate its container from the new image. Changes made in the sandbox are lost",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient.GetApiClient(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.WorkspaceAPI.RebuildWorkspace(ctx, args[0]).NoCache(noCacheFlag).Execute()
+		if err != nil {
+			return apiclient.HandleErrorResponse(res, err)
+		}
+
+		view_common.RenderInfoMessageBold(fmt.Sprintf("Rebuilding sandbox %s", args[0]))
+
+		// The rebuild request returns once the old container is removed, the sandbox is then built and started again
+		err = common.AwaitSandboxState(ctx, apiClient, args[0], daytonaapiclient.WORKSPACESTATE_STARTED)
+		if err != nil {
+			return err
+		}
+
+		view_common.RenderInfoMessageBold(fmt.Sprintf("Sandbox %s rebuilt", args[0]))
+		return nil
+	},
+}
+
+var noCacheFlag bool
+
+func init() {
+	RebuildCmd.Flags().BoolVar(&noCacheFlag, "no-cache", false, "Rebuild the image without the build cache")
+}
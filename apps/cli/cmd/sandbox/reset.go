@@ -0,0 +1,81 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	view_common "github.com/daytonaio/daytona/cli/views/common"
+	daytonaapiclient "github.com/daytonaio/daytona/daytonaapiclient"
+	"github.com/spf13/cobra"
+)
+
+var ResetCmd = &cobra.Command{
+	Use:   "reset [SANDBOX_ID]",
+	Short: "Reset the repository of a sandbox to its upstream branch",
+	Long:  "Fetch the remote of the repository in the sandbox and reset the current branch to its upstream branch. The sandbox and everything installed in it are kept",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		sandboxId := args[0]
+
+		apiClient, err := apiclient.GetApiClient(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		path := resetPathFlag
+		if path == "" {
+			projectDir, res, err := apiClient.ToolboxAPI.GetProjectDir(ctx, sandboxId).Execute()
+			if err != nil {
+				return apiclient.HandleErrorResponse(res, err)
+			}
+			if projectDir.Dir == nil {
+				return errors.New("project directory is unknown, set it with --path")
+			}
+			path = *projectDir.Dir
+		}
+
+		if !discardChangesFlag && !resetStashFlag {
+			status, err := getGitStatus(ctx, apiClient, sandboxId, path)
+			if err != nil {
+				return err
+			}
+			if len(status.FileStatus) > 0 {
+				return fmt.Errorf("%s has %d uncommitted changes, use --discard-changes to discard them or --stash to stash them", path, len(status.FileStatus))
+			}
+		}
+
+		resetRequest := daytonaapiclient.NewGitResetRequest(path)
+		if resetStashFlag {
+			resetRequest.SetMode("stash")
+		} else if discardChangesFlag {
+			resetRequest.SetDiscardChanges(true)
+		}
+
+		resetResponse, res, err := apiClient.ToolboxAPI.GitResetRepository(ctx, sandboxId).GitResetRequest(*resetRequest).Execute()
+		if err != nil {
+			return apiclient.HandleErrorResponse(res, err)
+		}
+
+		view_common.RenderInfoMessageBold(fmt.Sprintf("Reset %s in sandbox %s to %s", path, sandboxId, resetResponse.Hash))
+		return nil
+	},
+}
+
+var (
+	resetPathFlag      string
+	discardChangesFlag bool
+	resetStashFlag     bool
+)
+
+func init() {
+	ResetCmd.Flags().StringVar(&resetPathFlag, "path", "", "Path of the repository in the sandbox, defaults to the project directory")
+	ResetCmd.Flags().BoolVar(&discardChangesFlag, "discard-changes", false, "Discard uncommitted changes and untracked files")
+	ResetCmd.Flags().BoolVar(&resetStashFlag, "stash", false, "Stash uncommitted changes, including untracked files, before resetting")
+	ResetCmd.MarkFlagsMutuallyExclusive("discard-changes", "stash")
+}
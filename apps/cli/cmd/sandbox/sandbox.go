@@ -23,4 +23,11 @@ func init() {
 	SandboxCmd.AddCommand(DeleteCmd)
 	SandboxCmd.AddCommand(StartCmd)
 	SandboxCmd.AddCommand(StopCmd)
+	SandboxCmd.AddCommand(CpCmd)
+	SandboxCmd.AddCommand(DoctorCmd)
+	SandboxCmd.AddCommand(SyncCmd)
+	SandboxCmd.AddCommand(EventsCmd)
+	SandboxCmd.AddCommand(RebuildCmd)
+	SandboxCmd.AddCommand(ResetCmd)
+	SandboxCmd.AddCommand(AttachCmd)
 }
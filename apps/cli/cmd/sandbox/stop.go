@@ -5,7 +5,10 @@ package sandbox
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/daytonaio/daytona/cli/apiclient"
 	view_common "github.com/daytonaio/daytona/cli/views/common"
@@ -19,6 +22,10 @@ var StopCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
+		if stopTimeoutFlag < 0 {
+			return errors.New("timeout must not be negative")
+		}
+
 		apiClient, err := apiclient.GetApiClient(nil, nil)
 		if err != nil {
 			return err
@@ -29,12 +36,20 @@ var StopCmd = &cobra.Command{
 			return apiclient.HandleErrorResponse(res, err)
 		}
 
+		stopSandbox := func(sandboxId string) (*http.Response, error) {
+			stopRequest := apiClient.WorkspaceAPI.StopWorkspace(ctx, sandboxId)
+			if cmd.Flags().Changed("timeout") {
+				stopRequest = stopRequest.Timeout(float32(stopTimeoutFlag / time.Second))
+			}
+			return stopRequest.Execute()
+		}
+
 		if len(args) == 0 {
 			if allFlag {
 				var stoppedCount int
 
 				for _, w := range sandboxList {
-					res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, w.Id).Execute()
+					res, err := stopSandbox(w.Id)
 					if err != nil {
 						fmt.Printf("Failed to stop sandbox %s: %s\n", w.Id, apiclient.HandleErrorResponse(res, err))
 					} else {
@@ -62,7 +77,7 @@ var StopCmd = &cobra.Command{
 		case 0:
 			return fmt.Errorf("sandbox %s not found", args[0])
 		case 1:
-			res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, stopArg).Execute()
+			res, err := stopSandbox(stopArg)
 			if err != nil {
 				return apiclient.HandleErrorResponse(res, err)
 			}
@@ -76,6 +91,9 @@ var StopCmd = &cobra.Command{
 	},
 }
 
+var stopTimeoutFlag time.Duration
+
 func init() {
 	StopCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Stop all sandboxes")
+	StopCmd.Flags().DurationVar(&stopTimeoutFlag, "timeout", 0, "Time the sandbox gets to shut down before it is killed, overrides its stop grace period, e.g. 60s")
 }
@@ -0,0 +1,374 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/cli/apiclient"
+	view_common "github.com/daytonaio/daytona/cli/views/common"
+	"github.com/daytonaio/daytona/daytonaapiclient"
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var SyncCmd = &cobra.Command{
+	Use:   "sync [SANDBOX_ID] [LOCAL_PATH] [REMOTE_PATH]",
+	Short: "Sync a local directory into a sandbox",
+	Long: `Upload a local directory into a sandbox, skipping files ignored by .gitignore.
+
+With --watch, changes to the local directory are synced until interrupted, including
+deleted files. Changes are detected with file system events, or by scanning the directory
+every interval where those are not available. Sync errors are reported and the affected
+files are retried on the next change.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		sandboxId, localPath, remotePath := args[0], args[1], args[2]
+
+		info, err := os.Stat(localPath)
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			return fmt.Errorf("%s is not a directory", localPath)
+		}
+
+		if syncIntervalFlag <= 0 {
+			return fmt.Errorf("interval must be positive")
+		}
+
+		apiClient, err := apiclient.GetApiClient(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		s := &syncer{
+			apiClient:  apiClient,
+			sandboxId:  sandboxId,
+			localRoot:  localPath,
+			remoteRoot: remotePath,
+			synced:     map[string]syncEntry{},
+		}
+
+		current, err := s.snapshot()
+		if err != nil {
+			return err
+		}
+
+		failed := s.apply(ctx, current)
+		view_common.RenderInfoMessageBold(fmt.Sprintf("Synced %s to %s:%s", localPath, sandboxId, remotePath))
+
+		if !syncWatchFlag {
+			if failed > 0 {
+				return fmt.Errorf("failed to sync %d files", failed)
+			}
+			return nil
+		}
+
+		fmt.Println("Watching for changes, press Ctrl+C to stop")
+
+		watcher, err := s.newWatcher(current)
+		if err != nil {
+			log.Warnf("Failed to watch %s for changes, checking every %s instead: %v", localPath, syncIntervalFlag, err)
+			s.poll(ctx, current)
+			return nil
+		}
+		defer watcher.Close()
+
+		s.watch(ctx, watcher, current)
+		return nil
+	},
+}
+
+type syncEntry struct {
+	modTime int64
+	size    int64
+	mode    fs.FileMode
+}
+
+type syncer struct {
+	apiClient  *daytonaapiclient.APIClient
+	sandboxId  string
+	localRoot  string
+	remoteRoot string
+	// synced holds the entries as last uploaded, keyed by slash separated paths relative to localRoot
+	synced map[string]syncEntry
+}
+
+// snapshot lists the entries of the local directory that aren't ignored by .gitignore
+func (s *syncer) snapshot() (map[string]syncEntry, error) {
+	patterns, err := gitignore.ReadPatterns(osfs.New(s.localRoot), nil)
+	if err != nil {
+		return nil, err
+	}
+	matcher := gitignore.NewMatcher(patterns)
+
+	entries := map[string]syncEntry{}
+
+	err = filepath.WalkDir(s.localRoot, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(s.localRoot, localPath)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		if parts[0] == ".git" || matcher.Match(parts, entry.IsDir()) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+
+		e := syncEntry{mode: info.Mode()}
+		if !entry.IsDir() {
+			e.modTime = info.ModTime().UnixNano()
+			e.size = info.Size()
+		}
+		entries[filepath.ToSlash(rel)] = e
+
+		return nil
+	})
+
+	return entries, err
+}
+
+// apply uploads new and changed entries and deletes removed ones. Failures are logged and
+// the entries are left out of the synced state so they are retried. Returns the number of failures.
+func (s *syncer) apply(ctx context.Context, entries map[string]syncEntry) int {
+	failed := 0
+
+	paths := make([]string, 0, len(entries))
+	for p := range entries {
+		paths = append(paths, p)
+	}
+	// Parent directories sort before their contents
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		entry := entries[p]
+		if synced, ok := s.synced[p]; ok && synced == entry {
+			continue
+		}
+
+		remotePath := path.Join(s.remoteRoot, p)
+		mode := fmt.Sprintf("%04o", entry.mode.Perm())
+
+		var err error
+		if entry.mode.IsDir() {
+			var res *http.Response
+			res, err = s.apiClient.ToolboxAPI.CreateFolder(ctx, s.sandboxId).Path(remotePath).Mode(mode).Execute()
+			if err != nil {
+				err = apiclient.HandleErrorResponse(res, err)
+			}
+		} else {
+			err = uploadFile(ctx, s.apiClient, s.sandboxId, filepath.Join(s.localRoot, filepath.FromSlash(p)), remotePath, mode)
+			if err == nil {
+				fmt.Printf("%s -> %s:%s\n", p, s.sandboxId, remotePath)
+			}
+		}
+
+		if err != nil {
+			log.Errorf("Failed to sync %s: %v", p, err)
+			delete(s.synced, p)
+			failed++
+			continue
+		}
+
+		s.synced[p] = entry
+	}
+
+	var removed []string
+	for p := range s.synced {
+		if _, ok := entries[p]; !ok {
+			removed = append(removed, p)
+		}
+	}
+	// Contents sort after their parent directories, delete them first
+	sort.Sort(sort.Reverse(sort.StringSlice(removed)))
+
+	for _, p := range removed {
+		remotePath := path.Join(s.remoteRoot, p)
+
+		res, err := s.apiClient.ToolboxAPI.DeleteFile(ctx, s.sandboxId).Path(remotePath).Execute()
+		if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+			log.Errorf("Failed to delete %s: %v", p, apiclient.HandleErrorResponse(res, err))
+			failed++
+			continue
+		}
+
+		fmt.Printf("deleted %s:%s\n", s.sandboxId, remotePath)
+		delete(s.synced, p)
+	}
+
+	return failed
+}
+
+// newWatcher watches the local root and its synced directories for changes
+func (s *syncer) newWatcher(entries map[string]syncEntry) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addWatches(watcher, entries)
+	if err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	return watcher, nil
+}
+
+// addWatches adds the local root and the directories among entries to the watcher,
+// directories that are already watched are skipped by fsnotify
+func (s *syncer) addWatches(watcher *fsnotify.Watcher, entries map[string]syncEntry) error {
+	err := watcher.Add(s.localRoot)
+	if err != nil {
+		return err
+	}
+
+	for p, entry := range entries {
+		if !entry.mode.IsDir() {
+			continue
+		}
+
+		err := watcher.Add(filepath.Join(s.localRoot, filepath.FromSlash(p)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watch syncs changes reported by the watcher until the context is done. Changes are synced once
+// no events arrived for an interval so that bursts of writes, e.g. from a build or a checkout, are
+// synced together. Each sync rescans the directory, which also picks up files written to new
+// directories before they were watched.
+func (s *syncer) watch(ctx context.Context, watcher *fsnotify.Watcher, applied map[string]syncEntry) {
+	debounce := time.NewTimer(syncIntervalFlag)
+	debounce.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			debounce.Reset(syncIntervalFlag)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			// Events may have been dropped, rescan to catch up
+			log.Errorf("Failed to watch %s: %v", s.localRoot, err)
+			debounce.Reset(syncIntervalFlag)
+		case <-debounce.C:
+			next, err := s.snapshot()
+			if err != nil {
+				log.Errorf("Failed to scan %s: %v", s.localRoot, err)
+				continue
+			}
+
+			err = s.addWatches(watcher, next)
+			if err != nil {
+				log.Errorf("Failed to watch %s: %v", s.localRoot, err)
+			}
+
+			if !snapshotsEqual(applied, next) {
+				s.apply(ctx, next)
+				applied = next
+			}
+		}
+	}
+}
+
+// poll syncs changes by scanning the directory every interval until the context is done.
+// It is used when file system events are not available.
+func (s *syncer) poll(ctx context.Context, current map[string]syncEntry) {
+	applied := current
+
+	ticker := time.NewTicker(syncIntervalFlag)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			next, err := s.snapshot()
+			if err != nil {
+				log.Errorf("Failed to scan %s: %v", s.localRoot, err)
+				continue
+			}
+
+			// Changes are synced once the directory has been unchanged for an interval
+			// so that bursts of writes, e.g. from a build or a checkout, are synced together
+			if !snapshotsEqual(current, next) {
+				current = next
+				continue
+			}
+
+			if !snapshotsEqual(applied, current) {
+				s.apply(ctx, current)
+				applied = current
+			}
+		}
+	}
+}
+
+func snapshotsEqual(a, b map[string]syncEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for p, entry := range a {
+		if other, ok := b[p]; !ok || other != entry {
+			return false
+		}
+	}
+
+	return true
+}
+
+var syncWatchFlag bool
+var syncIntervalFlag time.Duration
+
+func init() {
+	SyncCmd.Flags().BoolVarP(&syncWatchFlag, "watch", "w", false, "Keep syncing local changes until interrupted")
+	SyncCmd.Flags().DurationVar(&syncIntervalFlag, "interval", time.Second, "Time without further changes before local changes are synced in watch mode")
+}
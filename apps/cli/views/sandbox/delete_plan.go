@@ -0,0 +1,65 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/cli/views/common"
+	"github.com/daytonaio/daytona/daytonaapiclient"
+)
+
+type DeletePlan struct {
+	Sandbox daytonaapiclient.Workspace
+	// Git status of the project directory, nil if it could not be checked
+	GitStatus *DeletePlanGitStatus
+	// Reason the git status could not be checked
+	GitStatusError string
+}
+
+type DeletePlanGitStatus struct {
+	ProjectDir         string
+	UncommittedChanges int
+	UnpushedCommits    int
+}
+
+func RenderDeletePlan(plans []DeletePlan) {
+	output := common.GetStyledMainTitle(fmt.Sprintf("Dry run - %d sandboxes would be deleted", len(plans))) + "\n\n"
+
+	for _, plan := range plans {
+		sandbox := plan.Sandbox
+
+		output += sandbox.Id
+		if sandbox.State != nil {
+			output += "  " + getStateLabel(*sandbox.State)
+		}
+		output += "\n"
+
+		if sandbox.Image != nil {
+			output += fmt.Sprintf("  Image: %s\n", *sandbox.Image)
+		}
+
+		if len(sandbox.Volumes) > 0 {
+			volumes := make([]string, 0, len(sandbox.Volumes))
+			for _, volume := range sandbox.Volumes {
+				volumes = append(volumes, fmt.Sprintf("%s (%s)", volume.VolumeId, volume.MountPath))
+			}
+			output += fmt.Sprintf("  Volumes: %s - detached, not deleted\n", strings.Join(volumes, ", "))
+		}
+
+		switch {
+		case plan.GitStatus == nil:
+			output += "  " + common.UndefinedStyle.Render(fmt.Sprintf("Uncommitted changes not checked: %s", plan.GitStatusError)) + "\n"
+		case plan.GitStatus.UncommittedChanges > 0 || plan.GitStatus.UnpushedCommits > 0:
+			output += "  " + common.ErrorStyle.Render(fmt.Sprintf("Warning: %d uncommitted changes and %d unpushed commits in %s would be lost", plan.GitStatus.UncommittedChanges, plan.GitStatus.UnpushedCommits, plan.GitStatus.ProjectDir)) + "\n"
+		default:
+			output += fmt.Sprintf("  No uncommitted changes in %s\n", plan.GitStatus.ProjectDir)
+		}
+
+		output += "\n"
+	}
+
+	fmt.Print(output)
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cli/views/common"
+)
+
+type DoctorCheckStatus string
+
+const (
+	DoctorCheckPassed  DoctorCheckStatus = "PASS"
+	DoctorCheckWarning DoctorCheckStatus = "WARN"
+	DoctorCheckFailed  DoctorCheckStatus = "FAIL"
+	DoctorCheckSkipped DoctorCheckStatus = "SKIP"
+)
+
+type DoctorCheck struct {
+	Name    string
+	Status  DoctorCheckStatus
+	Message string
+	// Suggested remediation, shown for failed and warning checks
+	Hint string
+}
+
+func RenderDoctorReport(sandboxId string, checks []DoctorCheck) {
+	output := common.GetStyledMainTitle(fmt.Sprintf("Sandbox %s diagnostics", sandboxId)) + "\n\n"
+
+	for _, check := range checks {
+		output += fmt.Sprintf("%s  %-16s %s\n", getDoctorStatusLabel(check.Status), check.Name, check.Message)
+		if check.Hint != "" && (check.Status == DoctorCheckFailed || check.Status == DoctorCheckWarning) {
+			output += fmt.Sprintf("%s  %-16s %s\n", "    ", "", common.UndefinedStyle.Render(check.Hint))
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getDoctorStatusLabel(status DoctorCheckStatus) string {
+	switch status {
+	case DoctorCheckPassed:
+		return common.StartedStyle.Render(string(status))
+	case DoctorCheckWarning:
+		return common.CreatingStyle.Render(string(status))
+	case DoctorCheckFailed:
+		return common.ErrorStyle.Render(string(status))
+	default:
+		return common.UndefinedStyle.Render(string(status))
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package sandbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cli/views/common"
+)
+
+type Event struct {
+	Time    time.Time `json:"time"`
+	Action  string    `json:"action"`
+	Message string    `json:"message"`
+}
+
+func RenderEvent(event Event) {
+	message := event.Message
+	switch event.Action {
+	case "oom", "die", "kill":
+		message = common.ErrorStyle.Render(message)
+	case "health_status":
+		message = common.PendingStyle.Render(message)
+	}
+
+	fmt.Printf("%s  %s\n", common.DefaultRowDataStyle.Render(event.Time.Local().Format(time.DateTime)), message)
+}
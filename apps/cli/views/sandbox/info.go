@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/cli/views/common"
@@ -44,6 +46,19 @@ func RenderInfo(sandbox *daytonaapiclient.Workspace, forceUnstyled bool) {
 
 	if sandbox.Info != nil {
 		output += getInfoLine("Created", util.GetTimeSinceLabelFromString(sandbox.Info.Created)) + "\n"
+
+		// Runtime details are only returned by the API for verbose requests
+		if sandbox.Info.HasImageDigest() {
+			output += getInfoLine("Image Digest", sandbox.Info.GetImageDigest()) + "\n"
+		}
+
+		if sandbox.Info.HasRestartCount() {
+			output += getInfoLine("Restarts", fmt.Sprintf("%d", int(sandbox.Info.GetRestartCount()))) + "\n"
+		}
+
+		if sandbox.Info.HasPhaseDurations() {
+			output += getInfoLine("Creation Phases", getPhaseDurationsLabel(sandbox.Info.GetPhaseDurations())) + "\n"
+		}
 	}
 
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
@@ -83,6 +98,22 @@ type providerMetadata struct {
 	UpdatedAt string `json:"updatedAt"`
 }
 
+func getPhaseDurationsLabel(phaseDurations map[string]float32) string {
+	phases := make([]string, 0, len(phaseDurations))
+	for phase := range phaseDurations {
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases)
+
+	labels := make([]string, 0, len(phases))
+	for _, phase := range phases {
+		duration := time.Duration(float64(phaseDurations[phase]) * float64(time.Second)).Round(time.Millisecond)
+		labels = append(labels, fmt.Sprintf("%s %s", phase, duration))
+	}
+
+	return strings.Join(labels, ", ")
+}
+
 func renderUnstyledInfo(output string) {
 	fmt.Println(output)
 }
@@ -7,12 +7,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/daytonaio/daytona/cli/views/common"
 	"github.com/daytonaio/daytona/cli/views/util"
 	"github.com/daytonaio/daytona/daytonaapiclient"
 )
 
+type SortField string
+
+const (
+	SortFieldId        SortField = "id"
+	SortFieldName      SortField = "name"
+	SortFieldCreated   SortField = "created"
+	SortFieldLastEvent SortField = "last-event"
+	SortFieldState     SortField = "state"
+)
+
+var SortFields = []SortField{SortFieldId, SortFieldName, SortFieldCreated, SortFieldLastEvent, SortFieldState}
+
 type RowData struct {
 	Name      string
 	State     string
@@ -63,6 +76,99 @@ func SortSandboxes(sandboxList *[]daytonaapiclient.Workspace) {
 	})
 }
 
+// FilterSandboxes returns the sandboxes whose ID, name, image or label values contain the search term, ignoring case
+func FilterSandboxes(sandboxList []daytonaapiclient.Workspace, search string) []daytonaapiclient.Workspace {
+	search = strings.ToLower(search)
+
+	filtered := []daytonaapiclient.Workspace{}
+	for _, sandbox := range sandboxList {
+		if matchesSearch(sandbox, search) {
+			filtered = append(filtered, sandbox)
+		}
+	}
+
+	return filtered
+}
+
+func matchesSearch(sandbox daytonaapiclient.Workspace, search string) bool {
+	values := []string{sandbox.Id, sandbox.Name, sandbox.GetImage()}
+	for _, v := range sandbox.Labels {
+		values = append(values, v)
+	}
+
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), search) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SortSandboxesBy sorts the list by a single field, falling back to the sandbox ID to keep the order stable
+func SortSandboxesBy(sandboxList *[]daytonaapiclient.Workspace, field SortField, descending bool) {
+	sort.SliceStable(*sandboxList, func(i, j int) bool {
+		vi, vj := getSortValue((*sandboxList)[i], field), getSortValue((*sandboxList)[j], field)
+		if vi == vj {
+			vi, vj = (*sandboxList)[i].Id, (*sandboxList)[j].Id
+		}
+
+		if descending {
+			return vi > vj
+		}
+		return vi < vj
+	})
+}
+
+func getSortValue(sandbox daytonaapiclient.Workspace, field SortField) string {
+	switch field {
+	case SortFieldCreated:
+		if sandbox.Info == nil {
+			return ""
+		}
+		return sandbox.Info.Created
+	case SortFieldLastEvent:
+		var providerMetadata providerMetadata
+		err := json.Unmarshal([]byte(sandbox.Info.GetProviderMetadata()), &providerMetadata)
+		if err != nil {
+			return ""
+		}
+		return providerMetadata.UpdatedAt
+	case SortFieldState:
+		if sandbox.State == nil {
+			return ""
+		}
+		order, ok := sandboxStateSortOrder[*sandbox.State]
+		if !ok {
+			order = 99
+		}
+		return fmt.Sprintf("%02d", order)
+	case SortFieldName:
+		return sandbox.Name
+	default:
+		return sandbox.Id
+	}
+}
+
+// Sorting by state follows the lifecycle of a sandbox, from being built to being destroyed
+var sandboxStateSortOrder = map[daytonaapiclient.WorkspaceState]int{
+	daytonaapiclient.WORKSPACESTATE_PENDING_BUILD:  1,
+	daytonaapiclient.WORKSPACESTATE_BUILDING_IMAGE: 2,
+	daytonaapiclient.WORKSPACESTATE_PULLING_IMAGE:  3,
+	daytonaapiclient.WORKSPACESTATE_CREATING:       4,
+	daytonaapiclient.WORKSPACESTATE_RESTORING:      5,
+	daytonaapiclient.WORKSPACESTATE_STARTING:       6,
+	daytonaapiclient.WORKSPACESTATE_STARTED:        7,
+	daytonaapiclient.WORKSPACESTATE_STOPPING:       8,
+	daytonaapiclient.WORKSPACESTATE_STOPPED:        9,
+	daytonaapiclient.WORKSPACESTATE_ARCHIVING:      10,
+	daytonaapiclient.WORKSPACESTATE_ARCHIVED:       11,
+	daytonaapiclient.WORKSPACESTATE_DESTROYING:     12,
+	daytonaapiclient.WORKSPACESTATE_DESTROYED:      13,
+	daytonaapiclient.WORKSPACESTATE_ERROR:          14,
+	daytonaapiclient.WORKSPACESTATE_UNKNOWN:        15,
+}
+
 func getTableRowData(sandbox daytonaapiclient.Workspace) *RowData {
 	rowData := RowData{"", "", "", "", ""}
 	rowData.Name = sandbox.Id + util.AdditionalPropertyPadding
@@ -6,6 +6,7 @@ package config
 import (
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
@@ -16,18 +17,28 @@ import (
 type Config struct {
 	ProjectDir  string
 	LogFilePath *string `envconfig:"DAYTONA_DAEMON_LOG_FILE_PATH"`
+	// Activity sources (toolbox, terminal) that should not count as sandbox activity
+	ActivityDisabledSources []string `envconfig:"DAYTONA_ACTIVITY_DISABLED_SOURCES"`
 }
 
 var DEFAULT_LOG_FILE_PATH = "/tmp/daytona-daemon.log"
 
-var config *Config
+var (
+	config     *Config
+	configErr  error
+	configOnce sync.Once
+)
 
 func GetConfig() (*Config, error) {
-	if config != nil {
-		return config, nil
-	}
+	configOnce.Do(func() {
+		config, configErr = loadConfig()
+	})
+
+	return config, configErr
+}
 
-	config = &Config{}
+func loadConfig() (*Config, error) {
+	config := &Config{}
 
 	err := envconfig.Process("", config)
 	if err != nil {
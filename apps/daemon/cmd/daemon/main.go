@@ -12,6 +12,7 @@ import (
 	golog "log"
 
 	"github.com/daytonaio/daemon/cmd/daemon/config"
+	"github.com/daytonaio/daemon/pkg/activity"
 	"github.com/daytonaio/daemon/pkg/terminal"
 	"github.com/daytonaio/daemon/pkg/toolbox"
 	log "github.com/sirupsen/logrus"
@@ -47,6 +48,8 @@ func main() {
 
 	initLogs(logWriter)
 
+	activity.Disable(c.ActivityDisabledSources...)
+
 	errChan := make(chan error)
 
 	toolBoxServer := &toolbox.Server{
@@ -0,0 +1,73 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package activity
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Source identifies where user activity inside the sandbox was observed.
+type Source string
+
+const (
+	// SourceToolbox covers toolbox API requests, including process execution
+	SourceToolbox Source = "toolbox"
+	// SourceTerminal covers input received by the web terminal
+	SourceTerminal Source = "terminal"
+)
+
+var (
+	mutex        sync.RWMutex
+	lastActivity = map[Source]time.Time{}
+	disabled     = map[Source]bool{}
+)
+
+// Disable stops recording activity for the given sources.
+// Unknown source names are ignored.
+func Disable(sources ...string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for _, source := range sources {
+		source = strings.TrimSpace(source)
+		if source == "" {
+			continue
+		}
+
+		disabled[Source(source)] = true
+		delete(lastActivity, Source(source))
+	}
+}
+
+// Touch records activity from the given source at the current time.
+func Touch(source Source) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if disabled[source] {
+		return
+	}
+
+	lastActivity[source] = time.Now()
+}
+
+// LastActivity returns the most recent activity across all sources together
+// with the last activity of each source. The time is zero if nothing was recorded.
+func LastActivity() (time.Time, map[Source]time.Time) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var last time.Time
+	sources := make(map[Source]time.Time, len(lastActivity))
+	for source, t := range lastActivity {
+		sources[source] = t
+		if t.After(last) {
+			last = t
+		}
+	}
+
+	return last, sources
+}
@@ -29,17 +29,7 @@ type SpawnTTYOptions struct {
 }
 
 func SpawnTTY(opts SpawnTTYOptions) error {
-	shell := GetShell()
-	cmd := exec.Command(shell)
-
-	cmd.Dir = opts.Dir
-
-	cmd.Env = append(cmd.Env, fmt.Sprintf("TERM=%s", opts.Term))
-	cmd.Env = append(cmd.Env, os.Environ()...)
-	cmd.Env = append(cmd.Env, fmt.Sprintf("SHELL=%s", shell))
-	cmd.Env = append(cmd.Env, opts.Env...)
-
-	f, err := pty.Start(cmd)
+	f, _, err := StartTTY(opts.Dir, opts.Term, opts.Env)
 	if err != nil {
 		return err
 	}
@@ -48,8 +38,7 @@ func SpawnTTY(opts SpawnTTYOptions) error {
 
 	go func() {
 		for win := range opts.SizeCh {
-			syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCSWINSZ),
-				uintptr(unsafe.Pointer(&struct{ h, w, x, y uint16 }{uint16(win.Height), uint16(win.Width), 0, 0})))
+			SetTTYSize(f, win)
 		}
 	}()
 
@@ -60,3 +49,28 @@ func SpawnTTY(opts SpawnTTYOptions) error {
 	_, err = io.Copy(opts.StdOut, f) // stdout
 	return err
 }
+
+// StartTTY starts the user's shell in a new pseudo terminal and returns the terminal and the started shell
+func StartTTY(dir, term string, env []string) (*os.File, *exec.Cmd, error) {
+	shell := GetShell()
+	cmd := exec.Command(shell)
+
+	cmd.Dir = dir
+
+	cmd.Env = append(cmd.Env, fmt.Sprintf("TERM=%s", term))
+	cmd.Env = append(cmd.Env, os.Environ()...)
+	cmd.Env = append(cmd.Env, fmt.Sprintf("SHELL=%s", shell))
+	cmd.Env = append(cmd.Env, env...)
+
+	f, err := pty.Start(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f, cmd, nil
+}
+
+func SetTTYSize(f *os.File, size TTYSize) {
+	syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCSWINSZ),
+		uintptr(unsafe.Pointer(&struct{ h, w, x, y uint16 }{uint16(size.Height), uint16(size.Width), 0, 0})))
+}
@@ -16,10 +16,19 @@ import (
 )
 
 func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	if auth == nil && s.CredentialProvider != nil {
+		var err error
+		auth, err = s.CredentialProvider.GetCredentials(repo.Url)
+		if err != nil {
+			return fmt.Errorf("failed to get credentials for %s: %w", repo.Url, err)
+		}
+	}
+
 	cloneOptions := &git.CloneOptions{
 		URL:             repo.Url,
 		SingleBranch:    true,
-		InsecureSkipTLS: true,
+		InsecureSkipTLS: s.InsecureSkipTLS,
+		CABundle:        s.CABundle,
 		Auth:            auth,
 	}
 
@@ -66,7 +75,11 @@ func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.Ba
 }
 
 func (s *Service) CloneRepositoryCmd(repo *gitprovider.GitRepository, auth *http.BasicAuth) []string {
-	cloneCmd := []string{"git", "clone", "--single-branch", "--branch", fmt.Sprintf("\"%s\"", repo.Branch)}
+	cloneCmd := []string{"git"}
+	if s.InsecureSkipTLS {
+		cloneCmd = append(cloneCmd, "-c", "http.sslVerify=false")
+	}
+	cloneCmd = append(cloneCmd, "clone", "--single-branch", "--branch", fmt.Sprintf("\"%s\"", repo.Branch))
 	cloneUrl := repo.Url
 
 	// Default to https protocol if not specified
@@ -0,0 +1,93 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// CredentialProvider fetches credentials for a repository just before they are needed,
+// so that long-lived tokens don't have to be passed in or stored
+type CredentialProvider interface {
+	GetCredentials(repoUrl string) (*http.BasicAuth, error)
+}
+
+// CredentialHelper is a CredentialProvider that runs a git credential helper, e.g. one that reads
+// short-lived tokens from Vault. Command is run by sh with "get" appended and receives the
+// repository in the git credential format on stdin, like git runs credential.helper commands.
+type CredentialHelper struct {
+	Command string
+	// Timeout of the command, defaults to DefaultCredentialHelperTimeout
+	Timeout time.Duration
+}
+
+// DefaultCredentialHelperTimeout bounds credential helpers so an unreachable secret store
+// doesn't block the clone
+const DefaultCredentialHelperTimeout = 30 * time.Second
+
+func (h *CredentialHelper) GetCredentials(repoUrl string) (*http.BasicAuth, error) {
+	parsedUrl, err := url.Parse(repoUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return nil, fmt.Errorf("failed to parse repository url %s", repoUrl)
+	}
+
+	input := fmt.Sprintf("protocol=%s\nhost=%s\npath=%s\n\n", parsedUrl.Scheme, parsedUrl.Host, strings.TrimPrefix(parsedUrl.Path, "/"))
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = DefaultCredentialHelperTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "sh", "-c", h.Command+" get")
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Processes started by the helper may keep the output open after sh is killed
+	cmd.WaitDelay = time.Second
+
+	err = cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("credential helper timed out after %s", timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("credential helper failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	auth := &http.BasicAuth{}
+
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "username":
+			auth.Username = value
+		case "password":
+			auth.Password = value
+		}
+	}
+
+	if auth.Password == "" {
+		return nil, fmt.Errorf("credential helper returned no password for %s", parsedUrl.Host)
+	}
+
+	return auth, nil
+}
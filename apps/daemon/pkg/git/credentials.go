@@ -0,0 +1,86 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+const credentialsFileName = "daytona-credentials"
+
+// StoreCredentials configures the git credential store of the repository with the given
+// credentials so fetch, pull and push inside the sandbox authenticate without prompting.
+// The credentials are kept inside the .git directory and only apply to this repository.
+func (s *Service) StoreCredentials(repoUrl string, auth *http.BasicAuth) error {
+	if auth == nil {
+		return nil
+	}
+
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	parsedUrl, err := url.Parse(repoUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return fmt.Errorf("failed to parse repository url %s", repoUrl)
+	}
+
+	credentialUrl := url.URL{
+		Scheme: parsedUrl.Scheme,
+		Host:   parsedUrl.Host,
+		User:   url.UserPassword(auth.Username, auth.Password),
+	}
+
+	credentialsPath := filepath.Join(s.ProjectDir, ".git", credentialsFileName)
+	err = os.WriteFile(credentialsPath, []byte(credentialUrl.String()+"\n"), 0600)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+
+	cfg.Raw.Section("credential").SetOption("helper", fmt.Sprintf("store --file=%s", credentialsPath))
+
+	return repo.SetConfig(cfg)
+}
+
+// RemoveCredentials removes credentials stored with StoreCredentials
+func (s *Service) RemoveCredentials() error {
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Raw.HasSection("credential") {
+		cfg.Raw.Section("credential").RemoveOption("helper")
+	}
+
+	err = repo.SetConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(s.ProjectDir, ".git", credentialsFileName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
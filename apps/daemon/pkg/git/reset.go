@@ -0,0 +1,126 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+var ErrDirtyWorktree = errors.New("worktree has uncommitted changes")
+
+const resetStashMessage = "daytona reset"
+
+type ResetMode string
+
+const (
+	// ResetModeHard discards local changes and untracked files
+	ResetModeHard ResetMode = "hard"
+	// ResetModeStash stashes local changes, including untracked files, before resetting
+	ResetModeStash ResetMode = "stash"
+)
+
+// ResetToUpstream fetches origin and resets the current branch to its tip on origin.
+// In hard mode a dirty worktree is only reset when discardChanges is set, otherwise
+// ErrDirtyWorktree is returned. Returns the hash the branch was reset to.
+func (s *Service) ResetToUpstream(auth *http.BasicAuth, mode ResetMode, discardChanges bool) (string, error) {
+	if mode != ResetModeHard && mode != ResetModeStash {
+		return "", fmt.Errorf("invalid reset mode %s", mode)
+	}
+
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return "", err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+
+	if !head.Name().IsBranch() {
+		return "", errors.New("HEAD is detached, checkout a branch before resetting")
+	}
+
+	w, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	status, err := w.Status()
+	if err != nil {
+		return "", err
+	}
+
+	dirty := !status.IsClean()
+	if dirty && mode == ResetModeHard && !discardChanges {
+		return "", ErrDirtyWorktree
+	}
+
+	// Fetch and resolve the upstream first so a failure leaves the worktree untouched
+	err = repo.Fetch(&git.FetchOptions{
+		RemoteName: "origin",
+		Auth:       auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return "", fmt.Errorf("failed to fetch origin: %w", err)
+	}
+
+	upstream, err := repo.Reference(plumbing.NewRemoteReferenceName("origin", head.Name().Short()), true)
+	if err != nil {
+		return "", fmt.Errorf("failed to find branch %s on origin: %w", head.Name().Short(), err)
+	}
+
+	stashed := false
+	if dirty && mode == ResetModeStash {
+		err = s.stashChanges()
+		if err != nil {
+			return "", err
+		}
+		stashed = true
+	}
+
+	err = w.Reset(&git.ResetOptions{
+		Commit: upstream.Hash(),
+		Mode:   git.HardReset,
+	})
+	if err != nil {
+		if stashed {
+			return "", fmt.Errorf("%w, changes were stashed as '%s'", err, resetStashMessage)
+		}
+		return "", err
+	}
+
+	if mode == ResetModeHard {
+		err = w.Clean(&git.CleanOptions{Dir: true})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return upstream.Hash().String(), nil
+}
+
+// stashChanges stashes local changes, including untracked files. git refuses to stash without
+// an identity, so a fallback one is used when none is configured.
+func (s *Service) stashChanges() error {
+	args := []string{"-C", s.ProjectDir}
+	if exec.Command("git", "-C", s.ProjectDir, "var", "GIT_COMMITTER_IDENT").Run() != nil {
+		args = append(args, "-c", "user.name=Daytona", "-c", "user.email=daytona@localhost")
+	}
+	args = append(args, "stash", "push", "--include-untracked", "-m", resetStashMessage)
+
+	// go-git does not support stashing
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to stash changes: %s", string(out))
+	}
+
+	return nil
+}
@@ -66,6 +66,13 @@ type Service struct {
 	GitConfigFileName string
 	LogWriter         io.Writer
 	OpenRepository    *git.Repository
+	// CABundle holds additional PEM encoded CA certificates trusted when cloning
+	CABundle []byte
+	// InsecureSkipTLS disables TLS certificate verification when cloning
+	InsecureSkipTLS bool
+	// CredentialProvider is asked for credentials when cloning without any.
+	// The credentials are only used for the clone and never stored.
+	CredentialProvider CredentialProvider
 }
 
 func (s *Service) RepositoryExists() (bool, error) {
@@ -4,10 +4,16 @@
 package git_test
 
 import (
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/daytonaio/daemon/pkg/git"
 	"github.com/daytonaio/daemon/pkg/gitprovider"
+	go_git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/stretchr/testify/suite"
 )
@@ -96,3 +102,139 @@ func (s *GitServiceTestSuite) TestCloneRepositoryCmd_WithoutCreds() {
 	cloneCmd = s.gitService.CloneRepositoryCmd(repoWithCloneTargetCommit, nil)
 	s.Require().Equal([]string{"git", "clone", "--single-branch", "--branch", "\"main\"", "https://github.com/daytonaio/daytona", "/workdir", "&&", "cd", "/workdir", "&&", "git", "checkout", "1234567890"}, cloneCmd)
 }
+
+func (s *GitServiceTestSuite) TestCloneRepositoryCmd_InsecureSkipTLS() {
+	gitService := &git.Service{
+		ProjectDir:      "/workdir",
+		InsecureSkipTLS: true,
+	}
+
+	cloneCmd := gitService.CloneRepositoryCmd(repoHttps, nil)
+	s.Require().Equal([]string{"git", "-c", "http.sslVerify=false", "clone", "--single-branch", "--branch", "\"main\"", "https://github.com/daytonaio/daytona", "/workdir"}, cloneCmd)
+}
+
+func (s *GitServiceTestSuite) TestCredentialHelper() {
+	helper := &git.CredentialHelper{Command: `f() { grep -q "host=github.com" && printf "username=daytonaio\npassword=Daytona123\n"; }; f`}
+
+	auth, err := helper.GetCredentials(repoHttps.Url)
+	s.Require().NoError(err)
+	s.Require().Equal(creds, auth)
+}
+
+func (s *GitServiceTestSuite) TestCredentialHelper_NoPassword() {
+	helper := &git.CredentialHelper{Command: `f() { cat > /dev/null; echo username=daytonaio; }; f`}
+
+	_, err := helper.GetCredentials(repoHttps.Url)
+	s.Require().Error(err)
+}
+
+func (s *GitServiceTestSuite) TestCredentialHelper_Timeout() {
+	helper := &git.CredentialHelper{Command: `f() { sleep 10; }; f`, Timeout: 100 * time.Millisecond}
+
+	start := time.Now()
+	_, err := helper.GetCredentials(repoHttps.Url)
+	s.Require().ErrorContains(err, "timed out")
+	s.Require().Less(time.Since(start), 5*time.Second)
+}
+
+func (s *GitServiceTestSuite) TestSetRepositoryUser() {
+	projectDir := s.T().TempDir()
+	_, err := go_git.PlainInit(projectDir, false)
+	s.Require().NoError(err)
+
+	gitService := &git.Service{ProjectDir: projectDir}
+
+	err = gitService.SetRepositoryUser("Daytona", "")
+	s.Require().NoError(err)
+
+	err = gitService.SetRepositoryUser("", "daytona@example.com")
+	s.Require().NoError(err)
+
+	repo, err := go_git.PlainOpen(projectDir)
+	s.Require().NoError(err)
+
+	cfg, err := repo.Config()
+	s.Require().NoError(err)
+	s.Require().Equal("Daytona", cfg.User.Name)
+	s.Require().Equal("daytona@example.com", cfg.User.Email)
+}
+
+func (s *GitServiceTestSuite) TestResetToUpstream_Stash() {
+	// Without a global identity, the fallback identity is used for the stash
+	s.T().Setenv("HOME", s.T().TempDir())
+	s.T().Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	originDir, projectDir := s.setupUpstream()
+	upstreamHash := s.commitFile(originDir, "README.md", "upstream")
+
+	s.Require().NoError(os.WriteFile(filepath.Join(projectDir, "README.md"), []byte("local"), 0644))
+	s.Require().NoError(os.WriteFile(filepath.Join(projectDir, "notes.txt"), []byte("untracked"), 0644))
+
+	gitService := &git.Service{ProjectDir: projectDir}
+
+	hash, err := gitService.ResetToUpstream(nil, git.ResetModeStash, false)
+	s.Require().NoError(err)
+	s.Require().Equal(upstreamHash, hash)
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "README.md"))
+	s.Require().NoError(err)
+	s.Require().Equal("upstream", string(content))
+	s.Require().NoFileExists(filepath.Join(projectDir, "notes.txt"))
+
+	out, err := exec.Command("git", "-C", projectDir, "stash", "list").CombinedOutput()
+	s.Require().NoError(err, string(out))
+	s.Require().Contains(string(out), "daytona reset")
+}
+
+func (s *GitServiceTestSuite) TestResetToUpstream_FetchFailureKeepsChanges() {
+	originDir, projectDir := s.setupUpstream()
+	s.Require().NoError(os.RemoveAll(originDir))
+
+	s.Require().NoError(os.WriteFile(filepath.Join(projectDir, "README.md"), []byte("local"), 0644))
+
+	gitService := &git.Service{ProjectDir: projectDir}
+
+	_, err := gitService.ResetToUpstream(nil, git.ResetModeStash, false)
+	s.Require().ErrorContains(err, "failed to fetch origin")
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "README.md"))
+	s.Require().NoError(err)
+	s.Require().Equal("local", string(content))
+
+	out, err := exec.Command("git", "-C", projectDir, "stash", "list").CombinedOutput()
+	s.Require().NoError(err, string(out))
+	s.Require().Empty(string(out))
+}
+
+// setupUpstream creates an origin repository with one commit and a clone of it
+func (s *GitServiceTestSuite) setupUpstream() (originDir, projectDir string) {
+	originDir = s.T().TempDir()
+	_, err := go_git.PlainInit(originDir, false)
+	s.Require().NoError(err)
+	s.commitFile(originDir, "README.md", "initial")
+
+	projectDir = s.T().TempDir()
+	_, err = go_git.PlainClone(projectDir, false, &go_git.CloneOptions{URL: originDir})
+	s.Require().NoError(err)
+
+	return originDir, projectDir
+}
+
+func (s *GitServiceTestSuite) commitFile(repoDir, name, content string) string {
+	repo, err := go_git.PlainOpen(repoDir)
+	s.Require().NoError(err)
+
+	w, err := repo.Worktree()
+	s.Require().NoError(err)
+
+	s.Require().NoError(os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0644))
+	_, err = w.Add(name)
+	s.Require().NoError(err)
+
+	hash, err := w.Commit("update "+name, &go_git.CommitOptions{
+		Author: &object.Signature{Name: "Daytona", Email: "daytona@example.com", When: time.Now()},
+	})
+	s.Require().NoError(err)
+
+	return hash.String()
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package git
+
+import "github.com/go-git/go-git/v5"
+
+// SetRepositoryUser writes user.name and user.email to the local config of the repository
+// so commits made inside the sandbox are attributed without a global git identity.
+// Empty values are left untouched.
+func (s *Service) SetRepositoryUser(name, email string) error {
+	if name == "" && email == "" {
+		return nil
+	}
+
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		cfg.User.Name = name
+	}
+
+	if email != "" {
+		cfg.User.Email = email
+	}
+
+	return repo.SetConfig(cfg)
+}
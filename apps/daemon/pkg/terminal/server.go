@@ -11,6 +11,7 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/daytonaio/daemon/pkg/activity"
 	"github.com/daytonaio/daemon/pkg/common"
 	"github.com/gorilla/websocket"
 )
@@ -54,6 +55,11 @@ func handleWebSocket(w http.ResponseWriter, r *http.Request) {
 	}
 	defer conn.Close()
 
+	if name := r.URL.Query().Get("session"); name != "" {
+		handlePersistentSession(conn, name)
+		return
+	}
+
 	sizeCh := make(chan common.TTYSize)
 	stdInReader, stdInWriter := io.Pipe()
 	stdOutReader, stdOutWriter := io.Pipe()
@@ -66,6 +72,8 @@ func handleWebSocket(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
+			activity.Touch(activity.SourceTerminal)
+
 			// Check if it's a resize message
 			if messageType == websocket.TextMessage {
 				var size windowSize
@@ -0,0 +1,224 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package terminal
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daemon/pkg/activity"
+	"github.com/daytonaio/daemon/pkg/common"
+	"github.com/gorilla/websocket"
+)
+
+// Persistent sessions keep their shell running when the websocket disconnects, so a client
+// can reattach to it with /ws?session=NAME. Detached sessions are closed after a timeout.
+const (
+	detachedSessionTimeout = 30 * time.Minute
+	// Output replayed to a client when it attaches
+	scrollbackSize       = 64 * 1024
+	maxSessionNameLength = 64
+)
+
+var (
+	sessions   = map[string]*persistentSession{}
+	sessionsMu sync.Mutex
+)
+
+type persistentSession struct {
+	name string
+	pty  *os.File
+	cmd  *exec.Cmd
+	done chan struct{}
+
+	mu          sync.Mutex
+	conn        *websocket.Conn
+	scrollback  []byte
+	detachTimer *time.Timer
+
+	// writeMu serializes writes to the attached client, it is never held while waiting for mu
+	writeMu sync.Mutex
+}
+
+func handlePersistentSession(conn *websocket.Conn, name string) {
+	if len(name) > maxSessionNameLength {
+		conn.WriteMessage(websocket.TextMessage, []byte("session name is too long\r\n"))
+		return
+	}
+
+	session, err := getOrStartSession(name)
+	if err != nil {
+		log.Printf("Failed to start session %s: %v", name, err)
+		return
+	}
+
+	session.attach(conn)
+	defer session.detach(conn)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// Disconnect the client when the shell exits
+	go func() {
+		select {
+		case <-session.done:
+			conn.Close()
+		case <-stop:
+		}
+	}()
+
+	for {
+		messageType, p, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		activity.Touch(activity.SourceTerminal)
+
+		if messageType == websocket.TextMessage {
+			var size windowSize
+			if err := json.Unmarshal(p, &size); err == nil {
+				common.SetTTYSize(session.pty, common.TTYSize{
+					Height: int(size.Rows),
+					Width:  int(size.Cols),
+				})
+				continue
+			}
+		}
+
+		_, err = session.pty.Write(p)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func getOrStartSession(name string) (*persistentSession, error) {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	if session, ok := sessions[name]; ok {
+		return session, nil
+	}
+
+	f, cmd, err := common.StartTTY("/", "xterm-256color", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &persistentSession{
+		name: name,
+		pty:  f,
+		cmd:  cmd,
+		done: make(chan struct{}),
+	}
+	sessions[name] = session
+
+	go session.readOutput()
+
+	return session, nil
+}
+
+// readOutput forwards the shell output to the attached client and keeps the latest output
+// for clients that attach later, until the shell exits
+func (s *persistentSession) readOutput() {
+	buf := make([]byte, 1024)
+	for {
+		n, err := s.pty.Read(buf)
+		if n > 0 {
+			s.mu.Lock()
+			s.scrollback = append(s.scrollback, buf[:n]...)
+			if len(s.scrollback) > scrollbackSize {
+				s.scrollback = append([]byte(nil), s.scrollback[len(s.scrollback)-scrollbackSize:]...)
+			}
+			conn := s.conn
+			s.mu.Unlock()
+
+			// Write outside of mu so a slow client doesn't block attaching and detaching
+			if conn != nil {
+				s.writeMu.Lock()
+				err := conn.WriteMessage(websocket.TextMessage, buf[:n])
+				s.writeMu.Unlock()
+				if err != nil {
+					log.Printf("Failed to write to websocket: %v", err)
+				}
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	_ = s.cmd.Wait()
+	s.pty.Close()
+
+	sessionsMu.Lock()
+	delete(sessions, s.name)
+	sessionsMu.Unlock()
+
+	s.mu.Lock()
+	if s.detachTimer != nil {
+		s.detachTimer.Stop()
+	}
+	s.mu.Unlock()
+
+	close(s.done)
+}
+
+// attach makes conn the client of the session and replays the recent output to it.
+// A client that was attached before is disconnected.
+func (s *persistentSession) attach(conn *websocket.Conn) {
+	s.mu.Lock()
+
+	if s.detachTimer != nil {
+		s.detachTimer.Stop()
+		s.detachTimer = nil
+	}
+
+	// Closing the previous client also fails a write to it that is still in progress
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	s.conn = conn
+
+	scrollback := append([]byte(nil), s.scrollback...)
+
+	// Take writeMu before releasing mu so the replay is sent before any later output
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.mu.Unlock()
+
+	if len(scrollback) > 0 {
+		err := conn.WriteMessage(websocket.TextMessage, scrollback)
+		if err != nil {
+			log.Printf("Failed to write to websocket: %v", err)
+		}
+	}
+}
+
+func (s *persistentSession) detach(conn *websocket.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another client took over the session
+	if s.conn != conn {
+		return
+	}
+	s.conn = nil
+
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	s.detachTimer = time.AfterFunc(detachedSessionTimeout, func() {
+		log.Printf("Closing session %s after being detached for %s", s.name, detachedSessionTimeout)
+		_ = s.cmd.Process.Kill()
+	})
+}
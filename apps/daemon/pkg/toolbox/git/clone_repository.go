@@ -4,13 +4,18 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
+	"syscall"
 
 	"github.com/daytonaio/daemon/pkg/git"
 	"github.com/daytonaio/daemon/pkg/gitprovider"
 	"github.com/gin-gonic/gin"
 	go_git_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	log "github.com/sirupsen/logrus"
 )
 
 func CloneRepository(c *gin.Context) {
@@ -39,6 +44,14 @@ func CloneRepository(c *gin.Context) {
 		ProjectDir: req.Path,
 	}
 
+	if req.CABundle != nil {
+		gitService.CABundle = []byte(*req.CABundle)
+	}
+
+	if req.InsecureSkipTLSVerify != nil {
+		gitService.InsecureSkipTLS = *req.InsecureSkipTLSVerify
+	}
+
 	var auth *go_git_http.BasicAuth
 
 	// Set authentication if provided
@@ -49,11 +62,51 @@ func CloneRepository(c *gin.Context) {
 		}
 	}
 
+	credentialHelper := os.Getenv("DAYTONA_GIT_CREDENTIAL_HELPER")
+	if req.CredentialHelper != nil {
+		credentialHelper = *req.CredentialHelper
+	}
+
+	if credentialHelper != "" {
+		gitService.CredentialProvider = &git.CredentialHelper{Command: credentialHelper}
+	}
+
 	err := gitService.CloneRepository(&repo, auth)
+	if err != nil && isDiskFullError(err) {
+		c.AbortWithError(http.StatusInsufficientStorage, fmt.Errorf("sandbox is out of disk space: %w", err))
+		return
+	}
 	if err != nil {
 		c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
 
+	if auth != nil && req.StoreCredentials != nil && *req.StoreCredentials {
+		err = gitService.StoreCredentials(repo.Url, auth)
+		if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to store git credentials: %w", err))
+			return
+		}
+	}
+
+	// The clone succeeded, so a failure to configure the identity doesn't fail the request
+	if req.GitUserName != nil || req.GitUserEmail != nil {
+		err = gitService.SetRepositoryUser(valueOrEmpty(req.GitUserName), valueOrEmpty(req.GitUserEmail))
+		if err != nil {
+			log.Warnf("Failed to set the git user of %s: %v", req.Path, err)
+		}
+	}
+
 	c.Status(http.StatusOK)
 }
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func isDiskFullError(err error) bool {
+	return errors.Is(err, syscall.ENOSPC) || strings.Contains(strings.ToLower(err.Error()), "no space left on device")
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daemon/pkg/git"
+	"github.com/gin-gonic/gin"
+	go_git_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+func ResetRepository(c *gin.Context) {
+	var req GitResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	mode := git.ResetModeHard
+	if req.Mode != nil {
+		mode = git.ResetMode(*req.Mode)
+	}
+
+	var auth *go_git_http.BasicAuth
+	if req.Username != nil && req.Password != nil {
+		auth = &go_git_http.BasicAuth{
+			Username: *req.Username,
+			Password: *req.Password,
+		}
+	}
+
+	gitService := git.Service{
+		ProjectDir: req.Path,
+	}
+
+	hash, err := gitService.ResetToUpstream(auth, mode, req.DiscardChanges != nil && *req.DiscardChanges)
+	if errors.Is(err, git.ErrDirtyWorktree) {
+		c.AbortWithError(http.StatusConflict, fmt.Errorf("%w, set discard_changes to reset anyway or use the stash mode", err))
+		return
+	}
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GitResetResponse{
+		Hash: hash,
+	})
+}
@@ -16,6 +16,20 @@ type GitCloneRequest struct {
 	Password *string `json:"password,omitempty" validate:"optional"`
 	Branch   *string `json:"branch,omitempty" validate:"optional"`
 	CommitID *string `json:"commit_id,omitempty" validate:"optional"`
+	// PEM encoded CA certificates used to verify the git server
+	CABundle *string `json:"ca_bundle,omitempty" validate:"optional"`
+	// disable TLS certificate verification
+	InsecureSkipTLSVerify *bool `json:"insecure_skip_tls_verify,omitempty" validate:"optional"`
+	// git identity configured in the cloned repository. When unset, git's own defaults apply,
+	// e.g. GIT_AUTHOR_NAME/GIT_AUTHOR_EMAIL or the global config
+	GitUserName  *string `json:"git_user_name,omitempty" validate:"optional"`
+	GitUserEmail *string `json:"git_user_email,omitempty" validate:"optional"`
+	// keep the credentials in the repository's credential store so git commands inside
+	// the sandbox authenticate, they can be removed with DELETE /git/credentials
+	StoreCredentials *bool `json:"store_credentials,omitempty" validate:"optional"`
+	// git credential helper command that is asked for fresh credentials when no username and password
+	// are given, defaults to DAYTONA_GIT_CREDENTIAL_HELPER. These credentials are never stored
+	CredentialHelper *string `json:"credential_helper,omitempty" validate:"optional"`
 } // @name GitCloneRequest
 
 type GitCommitRequest struct {
@@ -53,3 +67,17 @@ type GitCheckoutRequest struct {
 	Path   string `json:"path" validate:"required"`
 	Branch string `json:"branch" validate:"required"`
 } // @name GitCheckoutRequest
+
+type GitResetRequest struct {
+	Path     string  `json:"path" validate:"required"`
+	Username *string `json:"username,omitempty" validate:"optional"`
+	Password *string `json:"password,omitempty" validate:"optional"`
+	// hard (default) discards local changes, stash stashes them before resetting
+	Mode *string `json:"mode,omitempty" validate:"optional"`
+	// required to hard reset a worktree with uncommitted changes
+	DiscardChanges *bool `json:"discard_changes,omitempty" validate:"optional"`
+} // @name GitResetRequest
+
+type GitResetResponse struct {
+	Hash string `json:"hash" validate:"required"`
+} // @name GitResetResponse
@@ -0,0 +1,27 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package middlewares
+
+import (
+	"github.com/daytonaio/daemon/pkg/activity"
+	"github.com/gin-gonic/gin"
+)
+
+// Routes polled by the platform itself must not keep the sandbox alive
+var ignoreActivityPaths = map[string]bool{
+	"/activity":          true,
+	"/port":              true,
+	"/port/:port/in-use": true,
+	"/project-dir":       true,
+}
+
+func ActivityMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !ignoreActivityPaths[ctx.FullPath()] {
+			activity.Touch(activity.SourceToolbox)
+		}
+
+		ctx.Next()
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"time"
 
+	"github.com/daytonaio/daemon/pkg/activity"
 	"github.com/daytonaio/daemon/pkg/toolbox/config"
 	"github.com/daytonaio/daemon/pkg/toolbox/fs"
 	"github.com/daytonaio/daemon/pkg/toolbox/git"
@@ -42,6 +44,29 @@ func (s *Server) GetProjectDir(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, projectDir)
 }
 
+type ActivityResponse struct {
+	LastActivity *time.Time           `json:"lastActivity,omitempty"`
+	Sources      map[string]time.Time `json:"sources"`
+} // @name ActivityResponse
+
+func (s *Server) GetActivity(ctx *gin.Context) {
+	lastActivity, sources := activity.LastActivity()
+
+	response := ActivityResponse{
+		Sources: make(map[string]time.Time, len(sources)),
+	}
+
+	if !lastActivity.IsZero() {
+		response.LastActivity = &lastActivity
+	}
+
+	for source, t := range sources {
+		response.Sources[string(source)] = t
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (s *Server) Start() error {
 	// Set Gin to release mode in production
 	if os.Getenv("NODE_ENV") == "production" {
@@ -52,9 +77,11 @@ func (s *Server) Start() error {
 	r.Use(gin.Recovery())
 	r.Use(middlewares.LoggingMiddleware())
 	r.Use(middlewares.ErrorMiddleware())
+	r.Use(middlewares.ActivityMiddleware())
 	binding.Validator = new(DefaultValidator)
 
 	r.GET("/project-dir", s.GetProjectDir)
+	r.GET("/activity", s.GetActivity)
 
 	dirname, err := os.UserHomeDir()
 	if err != nil {
@@ -121,6 +148,8 @@ func (s *Server) Start() error {
 		gitController.POST("/commit", git.CommitChanges)
 		gitController.POST("/pull", git.PullChanges)
 		gitController.POST("/push", git.PushChanges)
+		gitController.POST("/reset", git.ResetRepository)
+		gitController.DELETE("/credentials", git.RemoveCredentials)
 	}
 
 	lspController := r.Group("/lsp")
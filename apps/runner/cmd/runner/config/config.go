@@ -9,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
@@ -30,18 +32,42 @@ type Config struct {
 	AWSAccessKeyId     string `envconfig:"AWS_ACCESS_KEY_ID"`
 	AWSSecretAccessKey string `envconfig:"AWS_SECRET_ACCESS_KEY"`
 	AWSDefaultBucket   string `envconfig:"AWS_DEFAULT_BUCKET"`
+	// Policies for sandboxes that request elevated access to the runner host
+	AllowPrivilegedSandboxes   bool `envconfig:"ALLOW_PRIVILEGED_SANDBOXES" default:"true"`
+	AllowDockerSocketSandboxes bool `envconfig:"ALLOW_DOCKER_SOCKET_SANDBOXES"`
+	// Comma separated Docker Hub mirrors, tried in order before Docker Hub itself
+	RegistryMirrors []string `envconfig:"REGISTRY_MIRRORS"`
+	// Comma separated runner environment variables that sandboxes may reference with host://VARNAME
+	HostEnvAllowlist []string `envconfig:"SANDBOX_HOST_ENV_ALLOWLIST"`
+	// Deadline for creating a sandbox, partially created sandboxes are removed when it expires. 0 disables it
+	SandboxCreateTimeout time.Duration `envconfig:"SANDBOX_CREATE_TIMEOUT" default:"15m"`
+	// Free space required on the Docker data root before a sandbox is created. 0 disables the check
+	MinFreeDiskSpaceMB uint64 `envconfig:"MIN_FREE_DISK_SPACE_MB" default:"1024"`
+	// Path of the Docker data root as seen by the runner, required for the disk checks when the runner is containerized
+	DockerDataRootPath string `envconfig:"DOCKER_DATA_ROOT_PATH"`
+	// Directory, e.g. a mounted secrets store, that sandbox files may be read from instead of passing their content
+	FilesSourceDir string `envconfig:"FILES_SOURCE_DIR"`
 }
 
 var DEFAULT_API_PORT int = 8080
 
-var config *Config
+var (
+	config     *Config
+	configErr  error
+	configOnce sync.Once
+)
 
+// GetConfig loads the runner configuration once and returns the same instance on every call
 func GetConfig() (*Config, error) {
-	if config != nil {
-		return config, nil
-	}
+	configOnce.Do(func() {
+		config, configErr = loadConfig()
+	})
+
+	return config, configErr
+}
 
-	config = &Config{}
+func loadConfig() (*Config, error) {
+	config := &Config{}
 
 	// Load .env file
 	err := godotenv.Load()
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"os/signal"
@@ -22,6 +23,7 @@ import (
 	"github.com/daytonaio/runner/pkg/models"
 	"github.com/daytonaio/runner/pkg/runner"
 	"github.com/daytonaio/runner/pkg/services"
+	"github.com/daytonaio/runner/pkg/tracing"
 	"github.com/docker/docker/client"
 
 	"github.com/rs/zerolog"
@@ -31,12 +33,27 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate-config" {
+		os.Exit(validateConfig())
+	}
+
 	cfg, err := config.GetConfig()
 	if err != nil {
 		log.Error(err)
 		return
 	}
 
+	shutdownTracing, err := tracing.Init(context.Background())
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Errorf("Failed to shut down tracing: %v", err)
+		}
+	}()
+
 	apiServer := api.NewApiServer(api.ApiServerConfig{
 		ApiPort:     cfg.ApiPort,
 		TLSCertFile: cfg.TLSCertFile,
@@ -44,7 +61,14 @@ func main() {
 		EnableTLS:   cfg.EnableTLS,
 	})
 
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	cli, err := newDockerClient()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	// Fail early if the Docker daemon is unreachable or the TLS configuration is wrong
+	err = pingDocker(cli)
 	if err != nil {
 		log.Error(err)
 		return
@@ -76,8 +100,18 @@ func main() {
 		AWSAccessKeyId:     cfg.AWSAccessKeyId,
 		AWSSecretAccessKey: cfg.AWSSecretAccessKey,
 		DaemonPath:         daemonPath,
+		AllowPrivileged:    cfg.AllowPrivilegedSandboxes,
+		AllowDockerSocket:  cfg.AllowDockerSocketSandboxes,
+		RegistryMirrors:    cfg.RegistryMirrors,
+		HostEnvAllowlist:   cfg.HostEnvAllowlist,
+		CreateTimeout:      cfg.SandboxCreateTimeout,
+		MinFreeDiskSpace:   cfg.MinFreeDiskSpaceMB * 1024 * 1024,
+		DataRootPath:       cfg.DockerDataRootPath,
+		FilesSourceDir:     cfg.FilesSourceDir,
 	})
 
+	dockerClient.SeedSandboxStates(ctx)
+
 	sandboxService := services.NewSandboxService(runnerCache, dockerClient)
 
 	_ = runner.GetInstance(&runner.RunnerInstanceConfig{
@@ -106,6 +140,24 @@ func main() {
 	}
 }
 
+func newDockerClient() (*client.Client, error) {
+	// client.FromEnv reads the standard Docker CLI variables: DOCKER_HOST for a remote daemon,
+	// DOCKER_TLS_VERIFY to connect with TLS and DOCKER_CERT_PATH for the directory with ca.pem, cert.pem and key.pem
+	return client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+}
+
+func pingDocker(cli *client.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := cli.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker daemon at %s: %w", cli.DaemonHost(), err)
+	}
+
+	return nil
+}
+
 func init() {
 	logLevel := log.WarnLevel
 
@@ -0,0 +1,175 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/runner/cmd/runner/config"
+	"github.com/daytonaio/runner/pkg/storage"
+)
+
+// errCheckSkipped is returned by checks of dependencies that are not configured
+var errCheckSkipped = errors.New("check skipped")
+
+type configCheck struct {
+	name string
+	run  func(cfg *config.Config) error
+}
+
+var configChecks = []configCheck{
+	{name: "log file", run: checkLogFile},
+	{name: "TLS certificate", run: checkTLSCertificate},
+	{name: "Docker daemon", run: checkDockerDaemon},
+	{name: "object storage", run: checkObjectStorage},
+	{name: "registry mirrors", run: checkRegistryMirrors},
+}
+
+// validateConfig loads the runner configuration and actively checks every configured
+// dependency. It prints a report and returns the exit code of the runner.
+func validateConfig() int {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		fmt.Printf("FAIL  configuration: %v\n", err)
+		return 1
+	}
+	fmt.Println("PASS  configuration")
+
+	failed := false
+	for _, check := range configChecks {
+		err := check.run(cfg)
+		switch {
+		case errors.Is(err, errCheckSkipped):
+			fmt.Printf("SKIP  %s: not configured\n", check.name)
+		case err != nil:
+			fmt.Printf("FAIL  %s: %v\n", check.name, err)
+			failed = true
+		default:
+			fmt.Printf("PASS  %s\n", check.name)
+		}
+	}
+
+	if failed {
+		return 1
+	}
+
+	return 0
+}
+
+func checkLogFile(cfg *config.Config) error {
+	if cfg.LogFilePath == "" {
+		return errCheckSkipped
+	}
+
+	err := os.MkdirAll(filepath.Dir(cfg.LogFilePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(cfg.LogFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+func checkTLSCertificate(cfg *config.Config) error {
+	if !cfg.EnableTLS {
+		return errCheckSkipped
+	}
+
+	_, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	return err
+}
+
+func checkDockerDaemon(cfg *config.Config) error {
+	cli, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	err = pingDocker(cli)
+	if err != nil {
+		return err
+	}
+
+	if cfg.ContainerRuntime == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	info, err := cli.Info(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := info.Runtimes[cfg.ContainerRuntime]; !ok {
+		return fmt.Errorf("container runtime %s is not available", cfg.ContainerRuntime)
+	}
+
+	return nil
+}
+
+func checkObjectStorage(cfg *config.Config) error {
+	if cfg.AWSEndpointUrl == "" {
+		return errCheckSkipped
+	}
+
+	client, err := storage.GetObjectStorageClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return client.Ping(ctx)
+}
+
+// checkRegistryMirrors makes sure every mirror serves the registry API. An unauthorized
+// response still counts as reachable.
+func checkRegistryMirrors(cfg *config.Config) error {
+	if len(cfg.RegistryMirrors) == 0 {
+		return errCheckSkipped
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	unreachable := []string{}
+	for _, mirror := range cfg.RegistryMirrors {
+		mirrorUrl := strings.TrimSuffix(mirror, "/")
+		if !strings.HasPrefix(mirrorUrl, "http://") && !strings.HasPrefix(mirrorUrl, "https://") {
+			mirrorUrl = "https://" + mirrorUrl
+		}
+
+		res, err := httpClient.Get(mirrorUrl + "/v2/")
+		if err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%s (%v)", mirror, err))
+			continue
+		}
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusUnauthorized {
+			unreachable = append(unreachable, fmt.Sprintf("%s (status %d)", mirror, res.StatusCode))
+		}
+	}
+
+	if len(unreachable) > 0 {
+		return fmt.Errorf("unreachable: %s", strings.Join(unreachable, ", "))
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -201,6 +202,7 @@ type ImageExistsResponse struct {
 //	@Description	Stream build logs
 //	@Param			imageRef	query		string	true	"Image ID or image ref without the tag"
 //	@Param			follow		query		boolean	false	"Whether to follow the log output"
+//	@Param			offset		query		integer	false	"Byte offset to resume the log output from, returned in the X-Log-Offset header of previous responses plus the bytes read"
 //	@Success		200			{string}	string	"Build logs stream"
 //	@Failure		400			{object}	common.ErrorResponse
 //	@Failure		401			{object}	common.ErrorResponse
@@ -219,19 +221,28 @@ func GetBuildLogs(ctx *gin.Context) {
 
 	follow := ctx.Query("follow") == "true"
 
+	var offset int64
+	if offsetParam := ctx.Query("offset"); offsetParam != "" {
+		parsedOffset, err := strconv.ParseInt(offsetParam, 10, 64)
+		if err != nil || parsedOffset < 0 {
+			ctx.Error(common.NewBadRequestError(fmt.Errorf("invalid offset %s: must be a non-negative integer", offsetParam)))
+			return
+		}
+		offset = parsedOffset
+	}
+
 	logFilePath, err := config.GetBuildLogFilePath(imageRef)
 	if err != nil {
 		ctx.Error(common.NewCustomError(http.StatusInternalServerError, err.Error(), "INTERNAL_SERVER_ERROR"))
 		return
 	}
 
-	if _, err := os.Stat(logFilePath); os.IsNotExist(err) {
+	logFileInfo, err := os.Stat(logFilePath)
+	if os.IsNotExist(err) {
 		ctx.Error(common.NewNotFoundError(fmt.Errorf("build logs not found for ref: %s", imageRef)))
 		return
 	}
 
-	ctx.Header("Content-Type", "application/octet-stream")
-
 	file, err := os.Open(logFilePath)
 	if err != nil {
 		ctx.Error(common.NewCustomError(http.StatusInternalServerError, err.Error(), "INTERNAL_SERVER_ERROR"))
@@ -239,6 +250,20 @@ func GetBuildLogs(ctx *gin.Context) {
 	}
 	defer file.Close()
 
+	// Log files are only appended to, so clients resume a broken stream from the offset of the bytes they received
+	if logFileInfo != nil && offset > logFileInfo.Size() {
+		offset = logFileInfo.Size()
+	}
+
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		ctx.Error(common.NewCustomError(http.StatusInternalServerError, err.Error(), "INTERNAL_SERVER_ERROR"))
+		return
+	}
+
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("X-Log-Offset", strconv.FormatInt(offset, 10))
+
 	// If not following, just return the entire file content
 	if !follow {
 		_, err = io.Copy(ctx.Writer, file)
@@ -4,13 +4,21 @@
 package controllers
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/daytonaio/runner/pkg/api/dto"
 	"github.com/daytonaio/runner/pkg/common"
+	"github.com/daytonaio/runner/pkg/docker"
 	"github.com/daytonaio/runner/pkg/models/enums"
 	"github.com/daytonaio/runner/pkg/runner"
+	"github.com/docker/docker/errdefs"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // Create 			godoc
@@ -202,6 +210,7 @@ func Start(ctx *gin.Context) {
 //	@Description	Stop sandbox
 //	@Produce		json
 //	@Param			workspaceId	path		string	true	"Sandbox ID"
+//	@Param			timeout		query		integer	false	"Seconds the sandbox gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the sandbox. 0 kills it immediately"
 //	@Success		200			{string}	string	"Sandbox stopped"
 //	@Failure		400			{object}	common.ErrorResponse
 //	@Failure		401			{object}	common.ErrorResponse
@@ -214,9 +223,20 @@ func Start(ctx *gin.Context) {
 func Stop(ctx *gin.Context) {
 	sandboxId := ctx.Param("workspaceId")
 
+	var gracePeriod *time.Duration
+	if timeoutParam := ctx.Query("timeout"); timeoutParam != "" {
+		timeout, err := strconv.Atoi(timeoutParam)
+		if err != nil || timeout < 0 {
+			ctx.Error(common.NewBadRequestError(fmt.Errorf("invalid timeout %s: must be a non-negative number of seconds", timeoutParam)))
+			return
+		}
+		duration := time.Duration(timeout) * time.Second
+		gracePeriod = &duration
+	}
+
 	runner := runner.GetInstance(nil)
 
-	err := runner.Docker.Stop(ctx.Request.Context(), sandboxId)
+	err := runner.Docker.Stop(ctx.Request.Context(), sandboxId, gracePeriod)
 	if err != nil {
 		runner.Cache.SetSandboxState(ctx, sandboxId, enums.SandboxStateError)
 		ctx.Error(err)
@@ -233,6 +253,7 @@ func Stop(ctx *gin.Context) {
 //	@Description	Get sandbox info
 //	@Produce		json
 //	@Param			workspaceId	path		string				true	"Sandbox ID"
+//	@Param			verbose		query		bool				false	"Include the container and image details of the sandbox"
 //	@Success		200			{object}	SandboxInfoResponse	"Sandbox info"
 //	@Failure		400			{object}	common.ErrorResponse
 //	@Failure		401			{object}	common.ErrorResponse
@@ -249,17 +270,123 @@ func Info(ctx *gin.Context) {
 
 	info := runner.SandboxService.GetSandboxStatesInfo(ctx.Request.Context(), sandboxId)
 
-	ctx.JSON(http.StatusOK, SandboxInfoResponse{
-		State:         info.SandboxState,
-		SnapshotState: info.SnapshotState,
-	})
+	var phaseDurations map[string]float64
+	if len(info.PhaseDurations) > 0 {
+		phaseDurations = make(map[string]float64, len(info.PhaseDurations))
+		for phase, duration := range info.PhaseDurations {
+			phaseDurations[phase] = duration.Seconds()
+		}
+	}
+
+	response := SandboxInfoResponse{
+		State:          info.SandboxState,
+		SnapshotState:  info.SnapshotState,
+		PhaseDurations: phaseDurations,
+	}
+
+	// Sandbox states are polled often, the container is only inspected when its details are requested
+	if ctx.Query("verbose") != "true" {
+		ctx.JSON(http.StatusOK, response)
+		return
+	}
+
+	// The container may not exist (yet or anymore), container details are only reported when it can be inspected
+	container, err := runner.Docker.ContainerInspect(ctx.Request.Context(), sandboxId)
+	if err == nil {
+		response.RestartCount = &container.RestartCount
+
+		resolvedImage, err := runner.Docker.ResolveSandboxImage(ctx.Request.Context(), container)
+		if err == nil {
+			response.Image = resolvedImage.Image
+			response.ImageId = resolvedImage.ImageId
+			response.ImageDigest = resolvedImage.Digest
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
 }
 
 type SandboxInfoResponse struct {
 	State         enums.SandboxState  `json:"state"`
 	SnapshotState enums.SnapshotState `json:"snapshotState"`
+	// Durations in seconds of the steps of the last sandbox creation
+	PhaseDurations map[string]float64 `json:"phaseDurations,omitempty"`
+	// Image reference the sandbox container is running
+	Image string `json:"image,omitempty"`
+	// Local ID of the image the sandbox container is running
+	ImageId string `json:"imageId,omitempty"`
+	// Registry digest of the running image, empty for locally built images
+	ImageDigest string `json:"imageDigest,omitempty"`
+	// Number of times the sandbox container was restarted by its restart policy
+	RestartCount *int `json:"restartCount,omitempty"`
 } //	@name	SandboxInfoResponse
 
+// Events 			godoc
+//
+//	@Tags			sandbox
+//	@Summary		Stream sandbox events
+//	@Description	Stream Docker events of the sandbox container, like OOM kills and restarts, as one JSON encoded SandboxEvent per line
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Sandbox ID"
+//	@Param			since		query		string				false	"Also return the events of this duration before now, e.g. 1h"
+//	@Success		200			{array}		docker.SandboxEvent	"Stream of sandbox events"
+//	@Failure		400			{object}	common.ErrorResponse
+//	@Failure		401			{object}	common.ErrorResponse
+//	@Failure		404			{object}	common.ErrorResponse
+//	@Failure		500			{object}	common.ErrorResponse
+//	@Router			/workspaces/{workspaceId}/events [get]
+//
+//	@id				Events
+func Events(ctx *gin.Context) {
+	sandboxId := ctx.Param("workspaceId")
+
+	var since time.Duration
+	if sinceParam := ctx.Query("since"); sinceParam != "" {
+		var err error
+		since, err = time.ParseDuration(sinceParam)
+		if err != nil || since < 0 {
+			ctx.Error(common.NewBadRequestError(fmt.Errorf("invalid since %s: must be a non-negative duration", sinceParam)))
+			return
+		}
+	}
+
+	runner := runner.GetInstance(nil)
+
+	_, err := runner.Docker.ContainerInspect(ctx.Request.Context(), sandboxId)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			ctx.Error(common.NewNotFoundError(fmt.Errorf("sandbox container not found: %w", err)))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	flusher, ok := ctx.Writer.(http.Flusher)
+	if !ok {
+		ctx.Error(common.NewCustomError(http.StatusInternalServerError, "Streaming not supported", "STREAMING_NOT_SUPPORTED"))
+		return
+	}
+
+	ctx.Header("Content-Type", "application/x-ndjson")
+	ctx.Status(http.StatusOK)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(ctx.Writer)
+
+	err = runner.Docker.StreamEvents(ctx.Request.Context(), sandboxId, since, func(event docker.SandboxEvent) error {
+		err := encoder.Encode(event)
+		if err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to stream events of sandbox %s: %v", sandboxId, err)
+	}
+}
+
 // RemoveDestroyed godoc
 //
 //	@Tags			sandbox
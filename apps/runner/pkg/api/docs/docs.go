@@ -176,6 +176,12 @@ const docTemplate = `{
                         "description": "Whether to follow the log output",
                         "name": "follow",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Byte offset to resume the log output from, returned in the X-Log-Offset header of previous responses plus the bytes read",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -411,6 +417,12 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include the container and image details of the sandbox",
+                        "name": "verbose",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -571,6 +583,69 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspaces/{workspaceId}/events": {
+            "get": {
+                "description": "Stream Docker events of the sandbox container, like OOM kills and restarts, as one JSON encoded SandboxEvent per line",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sandbox"
+                ],
+                "summary": "Stream sandbox events",
+                "operationId": "Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sandbox ID",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Also return the events of this duration before now, e.g. 1h",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of sandbox events",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/SandboxEvent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/workspaces/{workspaceId}/resize": {
             "post": {
                 "description": "Resize sandbox",
@@ -787,6 +862,12 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Seconds the sandbox gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the sandbox. 0 kills it immediately",
+                        "name": "timeout",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -921,6 +1002,10 @@ const docTemplate = `{
                     "description": "Image ID and tag or the build's hash",
                     "type": "string"
                 },
+                "noCache": {
+                    "description": "Rebuild even if the image exists and skip the build cache",
+                    "type": "boolean"
+                },
                 "organizationId": {
                     "type": "string"
                 },
@@ -941,10 +1026,27 @@ const docTemplate = `{
                 "userId"
             ],
             "properties": {
+                "cmd": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "cpuQuota": {
                     "type": "integer",
                     "minimum": 1
                 },
+                "dns": {
+                    "description": "Custom DNS servers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domainname": {
+                    "description": "Domain name of the sandbox container",
+                    "type": "string"
+                },
                 "entrypoint": {
                     "type": "array",
                     "items": {
@@ -957,6 +1059,20 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "extraHosts": {
+                    "description": "Additional host-to-IP mappings (format: HOST:IP)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "files": {
+                    "description": "Files written into the sandbox before it starts. With a read-only root filesystem, paths must be on a volume",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FileDTO"
+                    }
+                },
                 "fromVolumeId": {
                     "type": "string"
                 },
@@ -964,26 +1080,102 @@ const docTemplate = `{
                     "type": "integer",
                     "minimum": 0
                 },
+                "hostname": {
+                    "description": "Hostname of the sandbox container, defaults to the sandbox ID",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "image": {
                     "type": "string"
                 },
+                "imagePullPolicy": {
+                    "description": "Image pull policy for the sandbox and init step images (always, if-not-present, never).\nDefaults to always for images tagged latest and if-not-present otherwise",
+                    "type": "string"
+                },
+                "initSteps": {
+                    "description": "Steps that run to completion in order before the sandbox starts, sharing the volumes of the sandbox",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/InitStepDTO"
+                    }
+                },
                 "memoryQuota": {
                     "type": "integer",
                     "minimum": 1
                 },
+                "mountDockerSocket": {
+                    "description": "Bind mount the Docker socket of the runner host into the sandbox. This gives the sandbox full control over the host's Docker daemon",
+                    "type": "boolean"
+                },
+                "noStart": {
+                    "description": "Pull the image and create the container without starting it",
+                    "type": "boolean"
+                },
                 "osUser": {
                     "type": "string"
                 },
+                "postCreateCommands": {
+                    "description": "Shell commands run in order inside the sandbox after it first starts and becomes ready, e.g. to install dependencies.\nTheir output is written to the runner logs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "postCreateContinueOnError": {
+                    "description": "Keep the sandbox when a post-create command fails instead of failing the creation",
+                    "type": "boolean"
+                },
+                "privileged": {
+                    "description": "Run the sandbox container privileged, needed for Docker-in-Docker. Defaults to true if the runner allows\nprivileged sandboxes and no security settings are given",
+                    "type": "boolean"
+                },
+                "readinessProbe": {
+                    "$ref": "#/definitions/ReadinessProbeDTO"
+                },
+                "readonlyRootfs": {
+                    "description": "Mount the root filesystem of the sandbox read-only. /tmp, /run and the home directory of the user stay writable",
+                    "type": "boolean"
+                },
                 "registry": {
                     "$ref": "#/definitions/RegistryDTO"
                 },
+                "restartPolicy": {
+                    "description": "Restart policy of the sandbox container (no, always, unless-stopped, on-failure[:max-retries]), defaults to no",
+                    "type": "string"
+                },
+                "security": {
+                    "description": "Security profiles and capabilities of the sandbox container, Docker defaults are used when unset.\nSetting them makes the sandbox unprivileged, they can't be combined with privileged set to true",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/SecurityDTO"
+                        }
+                    ]
+                },
+                "stopGracePeriodSeconds": {
+                    "description": "Seconds the sandbox gets to shut down cleanly after SIGTERM before it is killed on stop.\nBy default the sandbox is killed immediately",
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "storageQuota": {
                     "type": "integer",
                     "minimum": 1
                 },
+                "tmpfs": {
+                    "description": "Additional tmpfs mounts (format: PATH[:OPTIONS], e.g. /var/cache:size=64m)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ulimits": {
+                    "description": "Resource limits of the sandbox processes. nofile defaults to a soft limit of 65536 and a hard limit of 1048576",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/UlimitDTO"
+                    }
+                },
                 "userId": {
                     "type": "string"
                 },
@@ -1048,6 +1240,30 @@ const docTemplate = `{
                 }
             }
         },
+        "FileDTO": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "content": {
+                    "description": "Base64 encoded content of the file",
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "Permission bits of the file as a decimal number (e.g. 384 for 0600), defaults to 0644",
+                    "type": "integer"
+                },
+                "path": {
+                    "description": "Absolute path of the file inside the sandbox",
+                    "type": "string"
+                },
+                "sourcePath": {
+                    "description": "Path of the file to copy in the files source directory of the runner (FILES_SOURCE_DIR), e.g. a mounted\nsecrets store, used instead of content. Sources are read when the sandbox is created and never logged",
+                    "type": "string"
+                }
+            }
+        },
         "ImageExistsResponse": {
             "type": "object",
             "properties": {
@@ -1057,6 +1273,29 @@ const docTemplate = `{
                 }
             }
         },
+        "InitStepDTO": {
+            "type": "object",
+            "required": [
+                "image"
+            ],
+            "properties": {
+                "command": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "entrypoint": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                }
+            }
+        },
         "PullImageRequestDTO": {
             "type": "object",
             "required": [
@@ -1071,6 +1310,39 @@ const docTemplate = `{
                 }
             }
         },
+        "ReadinessProbeDTO": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "description": "Command run inside the sandbox that must exit with code 0",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "intervalSeconds": {
+                    "description": "Delay between attempts in seconds",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "port": {
+                    "description": "TCP port inside the sandbox that must accept connections",
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 0
+                },
+                "retries": {
+                    "description": "Number of attempts before the probe is considered failed",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "timeoutSeconds": {
+                    "description": "Timeout of a single attempt in seconds",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
         "RegistryDTO": {
             "type": "object",
             "required": [
@@ -1110,9 +1382,46 @@ const docTemplate = `{
                 }
             }
         },
+        "SandboxEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "time": {
+                    "type": "string"
+                }
+            }
+        },
         "SandboxInfoResponse": {
             "type": "object",
             "properties": {
+                "image": {
+                    "description": "Image reference the sandbox container is running",
+                    "type": "string"
+                },
+                "imageDigest": {
+                    "description": "Registry digest of the running image, empty for locally built images",
+                    "type": "string"
+                },
+                "imageId": {
+                    "description": "Local ID of the image the sandbox container is running",
+                    "type": "string"
+                },
+                "phaseDurations": {
+                    "description": "Durations in seconds of the steps of the last sandbox creation",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "restartCount": {
+                    "description": "Number of times the sandbox container was restarted by its restart policy",
+                    "type": "integer"
+                },
                 "snapshotState": {
                     "$ref": "#/definitions/enums.SnapshotState"
                 },
@@ -1121,6 +1430,53 @@ const docTemplate = `{
                 }
             }
         },
+        "SecurityDTO": {
+            "type": "object",
+            "properties": {
+                "appArmorProfile": {
+                    "description": "Name of a loaded AppArmor profile, or \"unconfined\"",
+                    "type": "string"
+                },
+                "capAdd": {
+                    "description": "Linux capabilities to add, e.g. SYS_PTRACE",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "capDrop": {
+                    "description": "Linux capabilities to drop, e.g. NET_RAW",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "seccompProfile": {
+                    "description": "Path of a seccomp profile on the runner host, or \"unconfined\"",
+                    "type": "string"
+                }
+            }
+        },
+        "UlimitDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "hard": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "description": "Name of the limit, e.g. nofile or nproc",
+                    "type": "string"
+                },
+                "soft": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
         "dto.VolumeDTO": {
             "type": "object",
             "properties": {
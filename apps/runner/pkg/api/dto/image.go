@@ -15,4 +15,5 @@ type BuildImageRequestDTO struct {
 	OrganizationId         string       `json:"organizationId" validate:"required"`
 	Context                []string     `json:"context"`
 	PushToInternalRegistry bool         `json:"pushToInternalRegistry"`
+	NoCache                bool         `json:"noCache,omitempty"` // Rebuild even if the image exists and skip the build cache
 } //	@name	BuildImageRequestDTO
@@ -4,21 +4,120 @@
 package dto
 
 type CreateSandboxDTO struct {
-	Id           string            `json:"id" validate:"required"`
-	FromVolumeId string            `json:"fromVolumeId,omitempty"`
-	UserId       string            `json:"userId" validate:"required"`
-	Image        string            `json:"image" validate:"required"`
-	OsUser       string            `json:"osUser" validate:"required"`
-	CpuQuota     int64             `json:"cpuQuota" validate:"min=1"`
-	GpuQuota     int64             `json:"gpuQuota" validate:"min=0"`
-	MemoryQuota  int64             `json:"memoryQuota" validate:"min=1"`
-	StorageQuota int64             `json:"storageQuota" validate:"min=1"`
-	Env          map[string]string `json:"env,omitempty"`
-	Registry     *RegistryDTO      `json:"registry,omitempty"`
-	Entrypoint   []string          `json:"entrypoint,omitempty"`
-	Volumes      []VolumeDTO       `json:"volumes,omitempty"`
+	Id             string             `json:"id" validate:"required"`
+	FromVolumeId   string             `json:"fromVolumeId,omitempty"`
+	UserId         string             `json:"userId" validate:"required"`
+	Image          string             `json:"image" validate:"required"`
+	OsUser         string             `json:"osUser" validate:"required"`
+	CpuQuota       int64              `json:"cpuQuota" validate:"min=1"`
+	GpuQuota       int64              `json:"gpuQuota" validate:"min=0"`
+	MemoryQuota    int64              `json:"memoryQuota" validate:"min=1"`
+	StorageQuota   int64              `json:"storageQuota" validate:"min=1"`
+	Env            map[string]string  `json:"env,omitempty"`
+	Registry       *RegistryDTO       `json:"registry,omitempty"`
+	Entrypoint     []string           `json:"entrypoint,omitempty"`
+	Cmd            []string           `json:"cmd,omitempty"`
+	Volumes        []VolumeDTO        `json:"volumes,omitempty"`
+	ReadinessProbe *ReadinessProbeDTO `json:"readinessProbe,omitempty"`
+	// Custom DNS servers
+	Dns []string `json:"dns,omitempty"`
+	// Additional host-to-IP mappings (format: HOST:IP)
+	ExtraHosts []string `json:"extraHosts,omitempty"`
+	// Pull the image and create the container without starting it
+	NoStart bool `json:"noStart,omitempty"`
+	// Restart policy of the sandbox container (no, always, unless-stopped, on-failure[:max-retries]), defaults to no
+	RestartPolicy string `json:"restartPolicy,omitempty"`
+	// Mount the root filesystem of the sandbox read-only. /tmp, /run and the home directory of the user stay writable
+	ReadonlyRootfs bool `json:"readonlyRootfs,omitempty"`
+	// Additional tmpfs mounts (format: PATH[:OPTIONS], e.g. /var/cache:size=64m)
+	Tmpfs []string `json:"tmpfs,omitempty"`
+	// Image pull policy for the sandbox and init step images (always, if-not-present, never).
+	// Defaults to always for images tagged latest and if-not-present otherwise
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+	// Security profiles and capabilities of the sandbox container, Docker defaults are used when unset.
+	// Setting them makes the sandbox unprivileged, they can't be combined with privileged set to true
+	Security *SecurityDTO `json:"security,omitempty"`
+	// Run the sandbox container privileged, needed for Docker-in-Docker. Defaults to true if the runner allows
+	// privileged sandboxes and no security settings are given
+	Privileged *bool `json:"privileged,omitempty"`
+	// Bind mount the Docker socket of the runner host into the sandbox. This gives the sandbox full control over the host's Docker daemon
+	MountDockerSocket bool `json:"mountDockerSocket,omitempty"`
+	// Resource limits of the sandbox processes. nofile defaults to a soft limit of 65536 and a hard limit of 1048576
+	Ulimits []UlimitDTO `json:"ulimits,omitempty"`
+	// Steps that run to completion in order before the sandbox starts, sharing the volumes of the sandbox
+	InitSteps []InitStepDTO `json:"initSteps,omitempty"`
+	// Files written into the sandbox before it starts. With a read-only root filesystem, paths must be on a volume
+	Files []FileDTO `json:"files,omitempty"`
+	// Hostname of the sandbox container, defaults to the sandbox ID
+	Hostname string `json:"hostname,omitempty"`
+	// Domain name of the sandbox container
+	Domainname string `json:"domainname,omitempty"`
+	// Shell commands run in order inside the sandbox after it first starts and becomes ready, e.g. to install dependencies.
+	// Their output is written to the runner logs
+	PostCreateCommands []string `json:"postCreateCommands,omitempty"`
+	// Keep the sandbox when a post-create command fails instead of failing the creation
+	PostCreateContinueOnError bool `json:"postCreateContinueOnError,omitempty"`
+	// Seconds the sandbox gets to shut down cleanly after SIGTERM before it is killed on stop.
+	// By default the sandbox is killed immediately
+	StopGracePeriodSeconds int `json:"stopGracePeriodSeconds,omitempty" validate:"min=0"`
 } //	@name	CreateSandboxDTO
 
+// ReadinessProbeDTO describes how to check that a sandbox is ready to serve
+// requests after its container has started. Either Port or Command must be set.
+type ReadinessProbeDTO struct {
+	// TCP port inside the sandbox that must accept connections
+	Port int `json:"port,omitempty" validate:"min=0,max=65535"`
+	// Command run inside the sandbox that must exit with code 0
+	Command []string `json:"command,omitempty"`
+	// Number of attempts before the probe is considered failed
+	Retries int `json:"retries,omitempty" validate:"min=0"`
+	// Delay between attempts in seconds
+	IntervalSeconds int `json:"intervalSeconds,omitempty" validate:"min=0"`
+	// Timeout of a single attempt in seconds
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty" validate:"min=0"`
+} //	@name	ReadinessProbeDTO
+
+// SecurityDTO configures the security profiles and capabilities of a sandbox container.
+// Profiles and capabilities only take effect on unprivileged sandboxes, so they can't be used with privileged ones.
+type SecurityDTO struct {
+	// Path of a seccomp profile on the runner host, or "unconfined"
+	SeccompProfile string `json:"seccompProfile,omitempty"`
+	// Name of a loaded AppArmor profile, or "unconfined"
+	AppArmorProfile string `json:"appArmorProfile,omitempty"`
+	// Linux capabilities to add, e.g. SYS_PTRACE
+	CapAdd []string `json:"capAdd,omitempty"`
+	// Linux capabilities to drop, e.g. NET_RAW
+	CapDrop []string `json:"capDrop,omitempty"`
+} //	@name	SecurityDTO
+
+// InitStepDTO is a one-off container run before the sandbox starts, e.g. to seed data.
+// Its image is pulled with the registry credentials of the sandbox, and it runs unprivileged with the
+// resource limits, runtime, network and security settings of the sandbox.
+type InitStepDTO struct {
+	Image      string   `json:"image" validate:"required"`
+	Entrypoint []string `json:"entrypoint,omitempty"`
+	Command    []string `json:"command,omitempty"`
+} //	@name	InitStepDTO
+
+type FileDTO struct {
+	// Absolute path of the file inside the sandbox
+	Path string `json:"path" validate:"required"`
+	// Base64 encoded content of the file
+	Content string `json:"content,omitempty"`
+	// Path of the file to copy in the files source directory of the runner (FILES_SOURCE_DIR), e.g. a mounted
+	// secrets store, used instead of content. Sources are read when the sandbox is created and never logged
+	SourcePath string `json:"sourcePath,omitempty"`
+	// Permission bits of the file as a decimal number (e.g. 384 for 0600), defaults to 0644
+	Mode int `json:"mode,omitempty"`
+} //	@name	FileDTO
+
+type UlimitDTO struct {
+	// Name of the limit, e.g. nofile or nproc
+	Name string `json:"name" validate:"required"`
+	Soft int64  `json:"soft" validate:"min=0"`
+	Hard int64  `json:"hard" validate:"min=0"`
+} //	@name	UlimitDTO
+
 type ResizeSandboxDTO struct {
 	Cpu    int64 `json:"cpu" validate:"min=1"`
 	Gpu    int64 `json:"gpu" validate:"min=0"`
@@ -80,6 +80,24 @@ func ErrorMiddleware() gin.HandlerFunc {
 					Path:       ctx.Request.URL.Path,
 					Method:     ctx.Request.Method,
 				}
+			case *common.ReadinessProbeError:
+				errorResponse = common.ErrorResponse{
+					StatusCode: http.StatusServiceUnavailable,
+					Message:    err.Err.Error(),
+					Code:       "READINESS_PROBE_FAILED",
+					Timestamp:  time.Now(),
+					Path:       ctx.Request.URL.Path,
+					Method:     ctx.Request.Method,
+				}
+			case *common.DiskFullError:
+				errorResponse = common.ErrorResponse{
+					StatusCode: http.StatusInsufficientStorage,
+					Message:    err.Err.Error(),
+					Code:       "DISK_FULL",
+					Timestamp:  time.Now(),
+					Path:       ctx.Request.URL.Path,
+					Method:     ctx.Request.Method,
+				}
 			default:
 				errorResponse = handlePossibleDockerError(ctx, err.Err)
 			}
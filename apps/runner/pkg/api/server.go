@@ -80,6 +80,7 @@ func (a *ApiServer) Start() error {
 		gin.SetMode(gin.DebugMode)
 	}
 
+	a.router.Use(middlewares.TracingMiddleware())
 	a.router.Use(middlewares.LoggingMiddleware())
 	a.router.Use(middlewares.ErrorMiddleware())
 
@@ -103,6 +104,7 @@ func (a *ApiServer) Start() error {
 		sandboxController.POST("/:workspaceId/stop", controllers.Stop)
 		sandboxController.POST("/:workspaceId/snapshot", controllers.CreateSnapshot)
 		sandboxController.POST("/:workspaceId/resize", controllers.Resize)
+		sandboxController.GET("/:workspaceId/events", controllers.Events)
 		sandboxController.DELETE("/:workspaceId", controllers.RemoveDestroyed)
 
 		// Add proxy endpoint within the workspace controller for toolbox
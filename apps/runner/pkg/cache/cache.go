@@ -5,9 +5,11 @@ package cache
 
 import (
 	"context"
+	"maps"
 	"sync"
 	"time"
 
+	"github.com/daytonaio/runner/pkg/common"
 	"github.com/daytonaio/runner/pkg/models"
 	"github.com/daytonaio/runner/pkg/models/enums"
 )
@@ -15,6 +17,7 @@ import (
 type IRunnerCache interface {
 	SetSandboxState(ctx context.Context, sandboxId string, state enums.SandboxState)
 	SetSnapshotState(ctx context.Context, sandboxId string, state enums.SnapshotState)
+	SetSandboxPhaseDuration(ctx context.Context, sandboxId string, phase string, duration time.Duration)
 
 	Set(ctx context.Context, sandboxId string, data models.CacheData)
 	Get(ctx context.Context, sandboxId string) *models.CacheData
@@ -62,7 +65,9 @@ func (c *InMemoryRunnerCache) SetSandboxState(ctx context.Context, sandboxId str
 			SnapshotState:   enums.SnapshotStateNone,
 			DestructionTime: nil,
 		}
+		trackSandboxState(nil, state)
 	} else {
+		trackSandboxState(data, state)
 		data.SandboxState = state
 	}
 
@@ -80,6 +85,7 @@ func (c *InMemoryRunnerCache) SetSnapshotState(ctx context.Context, sandboxId st
 			SnapshotState:   state,
 			DestructionTime: nil,
 		}
+		trackSandboxState(nil, enums.SandboxStateUnknown)
 	} else {
 		data.SnapshotState = state
 	}
@@ -87,14 +93,42 @@ func (c *InMemoryRunnerCache) SetSnapshotState(ctx context.Context, sandboxId st
 	c.cache[sandboxId] = data
 }
 
+func (c *InMemoryRunnerCache) SetSandboxPhaseDuration(ctx context.Context, sandboxId string, phase string, duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	data, ok := c.cache[sandboxId]
+	if !ok {
+		data = &models.CacheData{
+			SandboxState:    enums.SandboxStateUnknown,
+			SnapshotState:   enums.SnapshotStateNone,
+			DestructionTime: nil,
+		}
+		trackSandboxState(nil, enums.SandboxStateUnknown)
+	}
+
+	// Copy on write, callers of Get may still be reading the previous map
+	phaseDurations := maps.Clone(data.PhaseDurations)
+	if phaseDurations == nil {
+		phaseDurations = make(map[string]time.Duration)
+	}
+	phaseDurations[phase] = duration
+	data.PhaseDurations = phaseDurations
+
+	c.cache[sandboxId] = data
+}
+
 func (c *InMemoryRunnerCache) Set(ctx context.Context, sandboxId string, data models.CacheData) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	trackSandboxState(c.cache[sandboxId], data.SandboxState)
+
 	c.cache[sandboxId] = &models.CacheData{
 		SandboxState:    data.SandboxState,
 		SnapshotState:   data.SnapshotState,
 		DestructionTime: data.DestructionTime,
+		PhaseDurations:  data.PhaseDurations,
 	}
 }
 
@@ -118,6 +152,8 @@ func (c *InMemoryRunnerCache) Remove(ctx context.Context, sandboxId string) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	trackSandboxState(c.cache[sandboxId], enums.SandboxStateDestroyed)
+
 	destructionTime := time.Now().Add(time.Duration(c.retentionDays) * 24 * time.Hour)
 	c.cache[sandboxId] = &models.CacheData{
 		SandboxState:    enums.SandboxStateDestroyed,
@@ -162,7 +198,18 @@ func (c *InMemoryRunnerCache) cleanupExpiredEntries() {
 	now := time.Now()
 	for id, data := range c.cache {
 		if data.DestructionTime != nil && (now.After(*data.DestructionTime) || now.Equal(*data.DestructionTime)) {
+			common.SandboxStateCount.WithLabelValues(string(data.SandboxState)).Dec()
 			delete(c.cache, id)
 		}
 	}
 }
+
+// trackSandboxState moves a sandbox from its previous state to the new one in the sandbox state gauge.
+// The caller must hold the cache mutex.
+func trackSandboxState(previous *models.CacheData, state enums.SandboxState) {
+	if previous != nil {
+		common.SandboxStateCount.WithLabelValues(string(previous.SandboxState)).Dec()
+	}
+
+	common.SandboxStateCount.WithLabelValues(string(state)).Inc()
+}
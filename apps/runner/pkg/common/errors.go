@@ -129,3 +129,39 @@ func NewBadRequestError(err error) error {
 func IsBadRequestError(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "bad request")
 }
+
+type ReadinessProbeError struct {
+	Message string
+}
+
+func (e *ReadinessProbeError) Error() string {
+	return e.Message
+}
+
+func NewReadinessProbeError(err error) error {
+	return &ReadinessProbeError{
+		Message: fmt.Sprintf("readiness probe failed: %s", err.Error()),
+	}
+}
+
+func IsReadinessProbeError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "readiness probe failed")
+}
+
+type DiskFullError struct {
+	Message string
+}
+
+func (e *DiskFullError) Error() string {
+	return e.Message
+}
+
+func NewDiskFullError(err error) error {
+	return &DiskFullError{
+		Message: fmt.Sprintf("runner is out of disk space: %s", err.Error()),
+	}
+}
+
+func IsDiskFullError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "out of disk space")
+}
@@ -36,4 +36,22 @@ var (
 		},
 		[]string{"operation", "status"},
 	)
+
+	// Histogram to track duration of image pulls
+	ImagePullDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "image_pull_duration_seconds",
+			Help:    "Time taken to pull images in seconds",
+			Buckets: []float64{1, 2.5, 5, 10, 20, 30, 60, 120, 300, 600, 1200},
+		},
+	)
+
+	// Gauge to track the number of sandboxes known to the runner by state
+	SandboxStateCount = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandbox_state_count",
+			Help: "Number of sandboxes by state",
+		},
+		[]string{"state"},
+	)
 )
@@ -6,6 +6,7 @@ package docker
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/daytonaio/runner/pkg/cache"
 	"github.com/docker/docker/client"
@@ -20,6 +21,18 @@ type DockerClientConfig struct {
 	AWSAccessKeyId     string
 	AWSSecretAccessKey string
 	DaemonPath         string
+	// Policies for sandboxes that request elevated access to the host
+	AllowPrivileged   bool
+	AllowDockerSocket bool
+	RegistryMirrors   []string
+	HostEnvAllowlist  []string
+	CreateTimeout     time.Duration
+	// Minimum free space in bytes on the Docker data root to create a sandbox, 0 disables the check
+	MinFreeDiskSpace uint64
+	// Docker data root as seen by the runner, defaults to the data root reported by Docker
+	DataRootPath string
+	// Runner directory, e.g. a mounted secrets store, that sandbox files may be read from with sourcePath
+	FilesSourceDir string
 }
 
 func NewDockerClient(config DockerClientConfig) *DockerClient {
@@ -33,6 +46,14 @@ func NewDockerClient(config DockerClientConfig) *DockerClient {
 		awsSecretAccessKey: config.AWSSecretAccessKey,
 		volumeMutexes:      make(map[string]*sync.Mutex),
 		daemonPath:         config.DaemonPath,
+		allowPrivileged:    config.AllowPrivileged,
+		allowDockerSocket:  config.AllowDockerSocket,
+		registryMirrors:    config.RegistryMirrors,
+		hostEnvAllowlist:   config.HostEnvAllowlist,
+		createTimeout:      config.CreateTimeout,
+		minFreeDiskSpace:   config.MinFreeDiskSpace,
+		dataRootPath:       config.DataRootPath,
+		filesSourceDir:     config.FilesSourceDir,
 	}
 }
 
@@ -47,4 +68,12 @@ type DockerClient struct {
 	volumeMutexes      map[string]*sync.Mutex
 	volumeMutexesMutex sync.Mutex
 	daemonPath         string
+	allowPrivileged    bool
+	allowDockerSocket  bool
+	registryMirrors    []string
+	hostEnvAllowlist   []string
+	createTimeout      time.Duration
+	minFreeDiskSpace   uint64
+	dataRootPath       string
+	filesSourceDir     string
 }
@@ -7,13 +7,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/daytonaio/runner/cmd/runner/config"
 	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
 
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+
+	log "github.com/sirupsen/logrus"
 )
 
+// RFC 1123 host name label
+var hostnameLabelRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+
 func (d *DockerClient) getContainerConfigs(ctx context.Context, sandboxDto dto.CreateSandboxDTO, volumeMountPathBinds []string) (*container.Config, *container.HostConfig, error) {
 	containerConfig := d.getContainerCreateConfig(sandboxDto)
 
@@ -26,22 +38,26 @@ func (d *DockerClient) getContainerConfigs(ctx context.Context, sandboxDto dto.C
 }
 
 func (d *DockerClient) getContainerCreateConfig(sandboxDto dto.CreateSandboxDTO) *container.Config {
-	envVars := []string{
-		"DAYTONA_WS_ID=" + sandboxDto.Id,
-		"DAYTONA_WS_IMAGE=" + sandboxDto.Image,
-		"DAYTONA_WS_USER=" + sandboxDto.OsUser,
+	hostname := sandboxDto.Hostname
+	if hostname == "" {
+		hostname = sandboxDto.Id
 	}
 
-	for key, value := range sandboxDto.Env {
-		envVars = append(envVars, fmt.Sprintf("%s=%s", key, value))
+	var stopTimeout *int
+	if sandboxDto.StopGracePeriodSeconds > 0 {
+		stopTimeout = &sandboxDto.StopGracePeriodSeconds
 	}
 
 	return &container.Config{
-		Hostname: sandboxDto.Id,
-		Image:    sandboxDto.Image,
+		Hostname:   hostname,
+		Domainname: sandboxDto.Domainname,
+		Image:      sandboxDto.Image,
 		// User:         sandboxDto.OsUser,
-		Env:          envVars,
+		Env:          resolveSandboxEnv(sandboxDto),
 		Entrypoint:   sandboxDto.Entrypoint,
+		Cmd:          sandboxDto.Cmd,
+		Labels:       getSandboxLabels(sandboxDto),
+		StopTimeout:  stopTimeout,
 		AttachStdout: true,
 		AttachStderr: true,
 	}
@@ -56,18 +72,61 @@ func (d *DockerClient) getContainerHostConfig(ctx context.Context, sandboxDto dt
 		binds = append(binds, volumeMountPathBinds...)
 	}
 
+	if sandboxDto.MountDockerSocket {
+		log.Warnf("Mounting the Docker socket into sandbox %s", sandboxDto.Id)
+		binds = append(binds, fmt.Sprintf("%s:%s", dockerSocketPath, dockerSocketPath))
+	}
+
 	hostConfig := &container.HostConfig{
-		Privileged: true,
-		ExtraHosts: []string{"host.docker.internal:host-gateway"},
+		Privileged: d.isPrivileged(sandboxDto),
+		ExtraHosts: append([]string{"host.docker.internal:host-gateway"}, sandboxDto.ExtraHosts...),
+		DNS:        sandboxDto.Dns,
 		Resources: container.Resources{
 			CPUPeriod:  100000,
 			CPUQuota:   sandboxDto.CpuQuota * 100000,
 			Memory:     sandboxDto.MemoryQuota * 1024 * 1024 * 1024,
 			MemorySwap: sandboxDto.MemoryQuota * 1024 * 1024 * 1024,
+			Ulimits:    getUlimits(sandboxDto.Ulimits),
 		},
 		Binds: binds,
 	}
 
+	restartPolicy, err := parseRestartPolicy(sandboxDto.RestartPolicy)
+	if err != nil {
+		return nil, err
+	}
+	hostConfig.RestartPolicy = restartPolicy
+
+	hostConfig.Tmpfs, err = parseTmpfs(sandboxDto.Tmpfs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applySecurityConfig(hostConfig, sandboxDto.Security)
+	if err != nil {
+		return nil, err
+	}
+
+	if sandboxDto.ReadonlyRootfs {
+		hostConfig.ReadonlyRootfs = true
+
+		// The daemon writes its log to /tmp and uses the home directory as the project directory
+		for _, path := range []string{"/tmp", "/run"} {
+			if _, ok := hostConfig.Tmpfs[path]; !ok {
+				hostConfig.Tmpfs[path] = ""
+			}
+		}
+
+		// An anonymous volume keeps the home directory writable and persists it across restarts
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:   mount.TypeVolume,
+			Target: getUserHomeDir(sandboxDto.OsUser),
+			VolumeOptions: &mount.VolumeOptions{
+				Labels: getHomeVolumeLabels(sandboxDto.Id),
+			},
+		})
+	}
+
 	containerRuntime := config.GetContainerRuntime()
 	if containerRuntime != "" {
 		hostConfig.Runtime = containerRuntime
@@ -101,3 +160,96 @@ func (d *DockerClient) getFilesystem(ctx context.Context) (string, error) {
 
 	return "", errors.New("filesystem not found")
 }
+
+func validateNetworkConfig(sandboxDto dto.CreateSandboxDTO) error {
+	if sandboxDto.Hostname != "" && !hostnameLabelRegex.MatchString(sandboxDto.Hostname) {
+		return common.NewBadRequestError(fmt.Errorf("invalid hostname %q: must be 1-63 alphanumeric characters or '-', and must not start or end with '-'", sandboxDto.Hostname))
+	}
+
+	if sandboxDto.Domainname != "" {
+		if len(sandboxDto.Domainname) > 253 {
+			return common.NewBadRequestError(fmt.Errorf("invalid domain name %q: must be at most 253 characters", sandboxDto.Domainname))
+		}
+
+		for _, label := range strings.Split(sandboxDto.Domainname, ".") {
+			if !hostnameLabelRegex.MatchString(label) {
+				return common.NewBadRequestError(fmt.Errorf("invalid domain name %q: %q is not a valid label", sandboxDto.Domainname, label))
+			}
+		}
+	}
+
+	for _, dns := range sandboxDto.Dns {
+		if net.ParseIP(dns) == nil {
+			return common.NewBadRequestError(fmt.Errorf("invalid DNS server %q: must be an IP address", dns))
+		}
+	}
+
+	for _, extraHost := range sandboxDto.ExtraHosts {
+		host, ip, ok := strings.Cut(extraHost, ":")
+		if !ok || host == "" || strings.ContainsAny(host, " \t") {
+			return common.NewBadRequestError(fmt.Errorf("invalid extra host %q: must be in HOST:IP format", extraHost))
+		}
+
+		if ip != "host-gateway" && net.ParseIP(ip) == nil {
+			return common.NewBadRequestError(fmt.Errorf("invalid extra host %q: %q is not a valid IP address", extraHost, ip))
+		}
+	}
+
+	return nil
+}
+
+// parseRestartPolicy parses a restart policy in the format accepted by docker run --restart
+func parseRestartPolicy(policy string) (container.RestartPolicy, error) {
+	if policy == "" {
+		return container.RestartPolicy{Name: container.RestartPolicyDisabled}, nil
+	}
+
+	name, maxRetries, hasMaxRetries := strings.Cut(policy, ":")
+
+	restartPolicy := container.RestartPolicy{
+		Name: container.RestartPolicyMode(name),
+	}
+
+	switch restartPolicy.Name {
+	case container.RestartPolicyDisabled, container.RestartPolicyAlways, container.RestartPolicyUnlessStopped:
+		if hasMaxRetries {
+			return container.RestartPolicy{}, common.NewBadRequestError(fmt.Errorf("invalid restart policy %q: max retries are only supported with %s", policy, container.RestartPolicyOnFailure))
+		}
+	case container.RestartPolicyOnFailure:
+		if hasMaxRetries {
+			count, err := strconv.Atoi(maxRetries)
+			if err != nil || count < 0 {
+				return container.RestartPolicy{}, common.NewBadRequestError(fmt.Errorf("invalid restart policy %q: max retries must be a non-negative integer", policy))
+			}
+			restartPolicy.MaximumRetryCount = count
+		}
+	default:
+		return container.RestartPolicy{}, common.NewBadRequestError(fmt.Errorf("invalid restart policy %q: must be one of no, always, unless-stopped or on-failure[:max-retries]", policy))
+	}
+
+	return restartPolicy, nil
+}
+
+// parseTmpfs converts PATH[:OPTIONS] tmpfs mounts to the format of the Docker host config
+func parseTmpfs(tmpfs []string) (map[string]string, error) {
+	mounts := make(map[string]string, len(tmpfs))
+
+	for _, t := range tmpfs {
+		path, options, _ := strings.Cut(t, ":")
+		if !filepath.IsAbs(path) {
+			return nil, common.NewBadRequestError(fmt.Errorf("invalid tmpfs mount %q: path must be absolute", t))
+		}
+
+		mounts[filepath.Clean(path)] = options
+	}
+
+	return mounts, nil
+}
+
+func getUserHomeDir(osUser string) string {
+	if osUser == "root" {
+		return "/root"
+	}
+
+	return filepath.Join("/home", osUser)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -16,12 +17,30 @@ import (
 	"github.com/daytonaio/runner/pkg/api/dto"
 	"github.com/daytonaio/runner/pkg/common"
 	"github.com/daytonaio/runner/pkg/models/enums"
+	"github.com/daytonaio/runner/pkg/tracing"
+	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/errdefs"
+	"go.opentelemetry.io/otel/attribute"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func (d *DockerClient) Create(ctx context.Context, sandboxDto dto.CreateSandboxDTO) (string, error) {
+// Phases of sandbox creation whose durations are recorded in the cache
+const (
+	PhasePullImage       = "pullImage"
+	PhaseCreateContainer = "createContainer"
+	PhaseStart           = "start"
+	PhaseReadiness       = "readiness"
+	PhaseInitSteps       = "initSteps"
+	PhasePostCreate      = "postCreate"
+)
+
+func (d *DockerClient) Create(ctx context.Context, sandboxDto dto.CreateSandboxDTO) (containerId string, err error) {
+	ctx, span := tracing.StartSpan(ctx, "sandbox.create", attribute.String("sandbox.id", sandboxDto.Id), attribute.String("image", sandboxDto.Image))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	startTime := time.Now()
 	defer func() {
 		obs, err := common.ContainerOperationDuration.GetMetricWithLabelValues("create")
@@ -30,6 +49,97 @@ func (d *DockerClient) Create(ctx context.Context, sandboxDto dto.CreateSandboxD
 		}
 	}()
 
+	defer func() {
+		err = d.wrapDiskFullError(ctx, err)
+	}()
+
+	// Backstop for the whole creation, individual phases may have shorter timeouts of their own
+	var createdContainerId string
+	if d.createTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.createTimeout)
+		defer cancel()
+
+		defer func() {
+			if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return
+			}
+
+			log.Errorf("Creating sandbox %s timed out after %s: %v", sandboxDto.Id, d.createTimeout, err)
+
+			// Only containers created by this call are removed, an existing sandbox that failed to start is kept
+			if createdContainerId != "" {
+				d.removeFailedContainer(createdContainerId)
+			}
+
+			err = common.NewCustomError(http.StatusGatewayTimeout, fmt.Sprintf("sandbox creation timed out after %s", d.createTimeout), "CREATE_TIMEOUT")
+		}()
+	}
+
+	err = validateReadinessProbe(sandboxDto.ReadinessProbe)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateNetworkConfig(sandboxDto)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = parseRestartPolicy(sandboxDto.RestartPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = parseTmpfs(sandboxDto.Tmpfs)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateSecurityConfig(sandboxDto.Security)
+	if err != nil {
+		return "", err
+	}
+
+	err = d.validateHostAccess(sandboxDto)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateUlimits(sandboxDto.Ulimits)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateInitSteps(sandboxDto.InitSteps)
+	if err != nil {
+		return "", err
+	}
+
+	err = d.validateFiles(sandboxDto.Files)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateImagePullPolicy(sandboxDto.ImagePullPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	err = validatePostCreateCommands(sandboxDto.PostCreateCommands)
+	if err != nil {
+		return "", err
+	}
+
+	if sandboxDto.StopGracePeriodSeconds < 0 {
+		return "", common.NewBadRequestError(errors.New("stop grace period must not be negative"))
+	}
+
+	sandboxDto.Env, err = d.resolveHostEnv(sandboxDto.Env)
+	if err != nil {
+		return "", err
+	}
+
 	state, err := d.DeduceSandboxState(ctx, sandboxDto.Id)
 	if err != nil && state == enums.SandboxStateError {
 		return "", err
@@ -40,21 +150,42 @@ func (d *DockerClient) Create(ctx context.Context, sandboxDto dto.CreateSandboxD
 	}
 
 	if state == enums.SandboxStateStopped || state == enums.SandboxStateCreating {
+		if sandboxDto.NoStart {
+			return sandboxDto.Id, nil
+		}
+
 		err = d.Start(ctx, sandboxDto.Id)
 		if err != nil {
 			return "", err
 		}
 
+		err = d.waitForReadiness(ctx, sandboxDto.Id, sandboxDto.ReadinessProbe)
+		if err != nil {
+			// The sandbox existed before this call, it is stopped instead of removed
+			stopErr := d.Stop(context.Background(), sandboxDto.Id, nil)
+			if stopErr != nil {
+				log.Warnf("Failed to stop sandbox %s after it did not become ready: %v", sandboxDto.Id, stopErr)
+			}
+			return "", err
+		}
+
 		return sandboxDto.Id, nil
 	}
 
 	d.cache.SetSandboxState(ctx, sandboxDto.Id, enums.SandboxStateCreating)
 
+	err = d.checkFreeDiskSpace(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	ctx = context.WithValue(ctx, constants.ID_KEY, sandboxDto.Id)
-	err = d.PullImage(ctx, sandboxDto.Image, sandboxDto.Registry)
+	phaseStartTime := time.Now()
+	err = d.pullImageWithPolicy(ctx, sandboxDto.Image, sandboxDto.Registry, ImagePullPolicy(sandboxDto.ImagePullPolicy))
 	if err != nil {
 		return "", err
 	}
+	d.cache.SetSandboxPhaseDuration(ctx, sandboxDto.Id, PhasePullImage, time.Since(phaseStartTime))
 
 	d.cache.SetSandboxState(ctx, sandboxDto.Id, enums.SandboxStateCreating)
 
@@ -77,11 +208,43 @@ func (d *DockerClient) Create(ctx context.Context, sandboxDto dto.CreateSandboxD
 		return "", err
 	}
 
-	c, err := d.apiClient.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, sandboxDto.Id)
+	phaseStartTime = time.Now()
+	createCtx, createSpan := tracing.StartSpan(ctx, "container.create")
+	c, err := d.apiClient.ContainerCreate(createCtx, containerConfig, hostConfig, nil, nil, sandboxDto.Id)
+	tracing.EndSpan(createSpan, err)
 	if err != nil {
 		return "", err
 	}
+	createdContainerId = c.ID
+	d.cache.SetSandboxPhaseDuration(ctx, sandboxDto.Id, PhaseCreateContainer, time.Since(phaseStartTime))
+
+	if len(sandboxDto.Files) > 0 {
+		err = d.copyFilesToContainer(ctx, c.ID, sandboxDto.Files)
+		if err != nil {
+			d.removeFailedContainer(c.ID)
+			return "", err
+		}
+	}
 
+	if len(sandboxDto.InitSteps) > 0 {
+		phaseStartTime = time.Now()
+		initCtx, initSpan := tracing.StartSpan(ctx, "sandbox.init_steps")
+		err = d.runInitSteps(initCtx, sandboxDto, c.ID)
+		tracing.EndSpan(initSpan, err)
+		if err != nil {
+			d.removeFailedContainer(c.ID)
+			return "", err
+		}
+		d.cache.SetSandboxPhaseDuration(ctx, sandboxDto.Id, PhaseInitSteps, time.Since(phaseStartTime))
+	}
+
+	// The sandbox is provisioned and can be brought up later with Start
+	if sandboxDto.NoStart {
+		d.cache.SetSandboxState(ctx, sandboxDto.Id, enums.SandboxStateStopped)
+		return c.ID, nil
+	}
+
+	phaseStartTime = time.Now()
 	err = d.Start(ctx, sandboxDto.Id)
 	if err != nil {
 		return "", err
@@ -120,9 +283,44 @@ func (d *DockerClient) Create(ctx context.Context, sandboxDto dto.CreateSandboxD
 		break
 	}
 
+	d.cache.SetSandboxPhaseDuration(ctx, sandboxDto.Id, PhaseStart, time.Since(phaseStartTime))
+
+	if sandboxDto.ReadinessProbe != nil {
+		phaseStartTime = time.Now()
+		readinessCtx, readinessSpan := tracing.StartSpan(ctx, "sandbox.readiness")
+		err = d.waitForReadiness(readinessCtx, c.ID, sandboxDto.ReadinessProbe)
+		tracing.EndSpan(readinessSpan, err)
+		if err != nil {
+			d.removeFailedContainer(c.ID)
+			return "", err
+		}
+		d.cache.SetSandboxPhaseDuration(ctx, sandboxDto.Id, PhaseReadiness, time.Since(phaseStartTime))
+	}
+
+	if len(sandboxDto.PostCreateCommands) > 0 {
+		phaseStartTime = time.Now()
+		postCreateCtx, postCreateSpan := tracing.StartSpan(ctx, "sandbox.post_create")
+		err = d.runPostCreateCommands(postCreateCtx, sandboxDto, c.ID)
+		tracing.EndSpan(postCreateSpan, err)
+		if err != nil {
+			d.removeFailedContainer(c.ID)
+			return "", err
+		}
+		d.cache.SetSandboxPhaseDuration(ctx, sandboxDto.Id, PhasePostCreate, time.Since(phaseStartTime))
+	}
+
 	return c.ID, nil
 }
 
+// removeFailedContainer removes a sandbox container whose setup failed or timed out,
+// so that a retried create sets it up again instead of only starting it
+func (d *DockerClient) removeFailedContainer(containerId string) {
+	err := d.apiClient.ContainerRemove(context.Background(), containerId, container.RemoveOptions{Force: true, RemoveVolumes: true})
+	if err != nil {
+		log.Warnf("Failed to remove sandbox container %s after a failed setup: %v", containerId, err)
+	}
+}
+
 func (p *DockerClient) validateImageArchitecture(ctx context.Context, image string) error {
 	inspect, _, err := p.apiClient.ImageInspectWithRaw(ctx, image)
 	if err != nil {
@@ -33,6 +33,8 @@ func (d *DockerClient) Destroy(ctx context.Context, containerId string) error {
 
 	d.cache.SetSandboxState(ctx, containerId, enums.SandboxStateDestroying)
 
+	d.removeInitStepContainers(ctx, containerId)
+
 	_, err := d.ContainerInspect(ctx, containerId)
 	if err != nil {
 		if errdefs.IsNotFound(err) {
@@ -51,6 +53,8 @@ func (d *DockerClient) Destroy(ctx context.Context, containerId string) error {
 		return err
 	}
 
+	d.removeHomeVolumes(ctx, containerId)
+
 	d.cache.SetSandboxState(ctx, containerId, enums.SandboxStateDestroyed)
 
 	return nil
@@ -0,0 +1,106 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/dustin/go-humanize"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type diskUsage struct {
+	Path  string
+	Total uint64
+	Free  uint64
+}
+
+func (u *diskUsage) String() string {
+	return fmt.Sprintf("%s free of %s on %s", humanize.IBytes(u.Free), humanize.IBytes(u.Total), u.Path)
+}
+
+// getDiskUsage returns the usage of the filesystem holding the Docker data root.
+// The data root reported by Docker is a path on the host, so a containerized runner
+// fails unless the path it is mounted at is configured.
+func (d *DockerClient) getDiskUsage(ctx context.Context) (*diskUsage, error) {
+	path := d.dataRootPath
+	if path == "" {
+		if _, err := os.Stat("/.dockerenv"); err == nil {
+			return nil, errors.New("the runner is containerized and the Docker data root path isn't configured")
+		}
+
+		info, err := d.apiClient.Info(ctx)
+		if err != nil {
+			return nil, err
+		}
+		path = info.DockerRootDir
+	}
+
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diskUsage{
+		Path:  path,
+		Total: stat.Blocks * uint64(stat.Bsize),
+		Free:  stat.Bavail * uint64(stat.Bsize),
+	}, nil
+}
+
+// checkFreeDiskSpace fails if less than the configured minimum of disk space is free.
+// The check is skipped if the disk usage can't be determined.
+func (d *DockerClient) checkFreeDiskSpace(ctx context.Context) error {
+	if d.minFreeDiskSpace == 0 {
+		return nil
+	}
+
+	usage, err := d.getDiskUsage(ctx)
+	if err != nil {
+		log.Debugf("Skipping the free disk space check: %v", err)
+		return nil
+	}
+
+	if usage.Free < d.minFreeDiskSpace {
+		return common.NewDiskFullError(fmt.Errorf("%s, at least %s is required", usage, humanize.IBytes(d.minFreeDiskSpace)))
+	}
+
+	return nil
+}
+
+func isDiskFullError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var diskFullErr *common.DiskFullError
+	if errors.As(err, &diskFullErr) {
+		return false
+	}
+
+	// Docker reports errors from the daemon as plain messages
+	return errors.Is(err, syscall.ENOSPC) || strings.Contains(strings.ToLower(err.Error()), "no space left on device")
+}
+
+// wrapDiskFullError turns errors caused by a full disk into a DiskFullError that includes the disk usage
+func (d *DockerClient) wrapDiskFullError(ctx context.Context, err error) error {
+	if !isDiskFullError(err) {
+		return err
+	}
+
+	usage, usageErr := d.getDiskUsage(context.WithoutCancel(ctx))
+	if usageErr != nil {
+		return common.NewDiskFullError(err)
+	}
+
+	return common.NewDiskFullError(fmt.Errorf("%s: %w", usage, err))
+}
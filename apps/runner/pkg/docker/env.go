@@ -0,0 +1,87 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Prefix of the environment variables the runner injects into every sandbox
+const protectedEnvPrefix = "DAYTONA_WS_"
+
+// Prefix of environment values that are resolved from the environment of the runner host
+const hostEnvPrefix = "host://"
+
+// resolveSandboxEnv merges the environment variables of a sandbox in a fixed precedence,
+// from lowest to highest:
+//
+//  1. ENV instructions of the image, applied by Docker and overridden by anything below
+//  2. Env of the create request, with host:// values already resolved by resolveHostEnv
+//  3. DAYTONA_WS_* variables injected by the runner, which cannot be overridden
+//
+// The result is sorted by key so the container config is deterministic.
+func resolveSandboxEnv(sandboxDto dto.CreateSandboxDTO) []string {
+	env := make(map[string]string, len(sandboxDto.Env)+3)
+
+	for key, value := range sandboxDto.Env {
+		if strings.HasPrefix(key, protectedEnvPrefix) {
+			log.Warnf("Ignoring environment variable %s for sandbox %s: %s* variables are set by the runner", key, sandboxDto.Id, protectedEnvPrefix)
+			continue
+		}
+		env[key] = value
+	}
+
+	env["DAYTONA_WS_ID"] = sandboxDto.Id
+	env["DAYTONA_WS_IMAGE"] = sandboxDto.Image
+	env["DAYTONA_WS_USER"] = sandboxDto.OsUser
+
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	envVars := make([]string, 0, len(keys))
+	for _, key := range keys {
+		envVars = append(envVars, fmt.Sprintf("%s=%s", key, env[key]))
+	}
+
+	return envVars
+}
+
+// resolveHostEnv replaces host://VARNAME values with the value of VARNAME on the runner host.
+// Only allowlisted variables can be resolved so sandboxes can't read the runner's secrets.
+func (d *DockerClient) resolveHostEnv(env map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(env))
+
+	for key, value := range env {
+		name, ok := strings.CutPrefix(value, hostEnvPrefix)
+		if !ok {
+			resolved[key] = value
+			continue
+		}
+
+		if !slices.Contains(d.hostEnvAllowlist, name) {
+			return nil, common.NewBadRequestError(fmt.Errorf("environment variable %s: host variable %s is not allowed on this runner", key, name))
+		}
+
+		hostValue, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, common.NewBadRequestError(fmt.Errorf("environment variable %s: host variable %s is not set on the runner", key, name))
+		}
+
+		resolved[key] = hostValue
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+)
+
+func TestResolveSandboxEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want []string
+	}{
+		{
+			name: "runner variables only",
+			want: []string{"DAYTONA_WS_ID=sandbox", "DAYTONA_WS_IMAGE=ubuntu:22.04", "DAYTONA_WS_USER=daytona"},
+		},
+		{
+			name: "request variables are sorted by key",
+			env:  map[string]string{"ZED": "1", "APP_ENV": "dev"},
+			want: []string{"APP_ENV=dev", "DAYTONA_WS_ID=sandbox", "DAYTONA_WS_IMAGE=ubuntu:22.04", "DAYTONA_WS_USER=daytona", "ZED=1"},
+		},
+		{
+			name: "runner variables cannot be overridden",
+			env:  map[string]string{"DAYTONA_WS_ID": "other", "DAYTONA_WS_CUSTOM": "x", "PATH": "/bin"},
+			want: []string{"DAYTONA_WS_ID=sandbox", "DAYTONA_WS_IMAGE=ubuntu:22.04", "DAYTONA_WS_USER=daytona", "PATH=/bin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveSandboxEnv(dto.CreateSandboxDTO{
+				Id:     "sandbox",
+				Image:  "ubuntu:22.04",
+				OsUser: "daytona",
+				Env:    tt.env,
+			})
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveSandboxEnv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// SandboxEvent is a Docker event of a sandbox container with a readable description
+type SandboxEvent struct {
+	Time    time.Time `json:"time"`
+	Action  string    `json:"action"`
+	Message string    `json:"message"`
+} //	@name	SandboxEvent
+
+// Container actions reported as sandbox events, others like exec_create are too noisy to be useful
+var sandboxEventActions = map[events.Action]bool{
+	events.ActionStart:   true,
+	events.ActionRestart: true,
+	events.ActionStop:    true,
+	events.ActionKill:    true,
+	events.ActionDie:     true,
+	events.ActionOOM:     true,
+	events.ActionPause:   true,
+	events.ActionUnPause: true,
+	events.ActionDestroy: true,
+}
+
+// StreamEvents calls onEvent with the events of the sandbox container until ctx is done or onEvent fails.
+// Events that happened within since before now are replayed first, as far as the Docker daemon still has them.
+func (d *DockerClient) StreamEvents(ctx context.Context, sandboxId string, since time.Duration, onEvent func(SandboxEvent) error) error {
+	options := events.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", string(events.ContainerEventType)),
+			filters.Arg("container", sandboxId),
+		),
+	}
+
+	if since > 0 {
+		options.Since = fmt.Sprint(time.Now().Add(-since).Unix())
+	}
+
+	messages, errs := d.apiClient.Events(ctx, options)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		case message := <-messages:
+			event, ok := getSandboxEvent(message)
+			if !ok {
+				continue
+			}
+
+			err := onEvent(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func getSandboxEvent(message events.Message) (SandboxEvent, bool) {
+	action := message.Action
+	attributes := message.Actor.Attributes
+
+	var text string
+	switch {
+	case strings.HasPrefix(string(action), string(events.ActionHealthStatus)):
+		text = fmt.Sprintf("Health status changed to %s", strings.TrimSpace(strings.TrimPrefix(string(action), string(events.ActionHealthStatus)+":")))
+		action = events.ActionHealthStatus
+	case !sandboxEventActions[action]:
+		return SandboxEvent{}, false
+	case action == events.ActionOOM:
+		text = "Out of memory, a process in the sandbox was killed. Consider increasing the memory of the sandbox"
+	case action == events.ActionDie:
+		text = fmt.Sprintf("Sandbox exited with code %s", attributes["exitCode"])
+	case action == events.ActionKill:
+		text = fmt.Sprintf("Sandbox received signal %s", attributes["signal"])
+	case action == events.ActionStart:
+		text = "Sandbox started"
+	case action == events.ActionRestart:
+		text = "Sandbox restarted"
+	case action == events.ActionStop:
+		text = "Sandbox stopped"
+	case action == events.ActionPause:
+		text = "Sandbox paused"
+	case action == events.ActionUnPause:
+		text = "Sandbox resumed"
+	case action == events.ActionDestroy:
+		text = "Sandbox removed"
+	}
+
+	return SandboxEvent{
+		Time:    time.Unix(0, message.TimeNano),
+		Action:  string(action),
+		Message: text,
+	}, true
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
+)
+
+const defaultFileMode = 0644
+
+func (d *DockerClient) validateFiles(files []dto.FileDTO) error {
+	seen := make(map[string]bool, len(files))
+
+	for _, file := range files {
+		if !path.IsAbs(file.Path) || strings.HasSuffix(file.Path, "/") {
+			return common.NewBadRequestError(fmt.Errorf("invalid file path %q: must be an absolute file path", file.Path))
+		}
+
+		filePath := path.Clean(file.Path)
+		if seen[filePath] {
+			return common.NewBadRequestError(fmt.Errorf("file %s is set more than once", filePath))
+		}
+		seen[filePath] = true
+
+		if file.Mode < 0 || file.Mode > 0777 {
+			return common.NewBadRequestError(fmt.Errorf("invalid mode %o for file %s: must be between 0 and 0777", file.Mode, filePath))
+		}
+
+		_, err := d.getFileContent(file)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getFileContent returns the content of a file, either decoded from the request or read from the files source directory
+func (d *DockerClient) getFileContent(file dto.FileDTO) ([]byte, error) {
+	if file.SourcePath == "" {
+		content, err := base64.StdEncoding.DecodeString(file.Content)
+		if err != nil {
+			return nil, common.NewBadRequestError(fmt.Errorf("invalid content for file %s: must be base64 encoded", file.Path))
+		}
+		return content, nil
+	}
+
+	if file.Content != "" {
+		return nil, common.NewBadRequestError(fmt.Errorf("file %s: set either content or sourcePath", file.Path))
+	}
+
+	if d.filesSourceDir == "" {
+		return nil, common.NewBadRequestError(fmt.Errorf("file %s: file sources are not configured on this runner", file.Path))
+	}
+
+	sourceDir, err := filepath.EvalSymlinks(d.filesSourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the files source directory: %w", err)
+	}
+
+	// Secret mounts commonly link their files into place, so links are followed but must stay inside the directory
+	sourcePath, err := filepath.EvalSymlinks(filepath.Join(sourceDir, filepath.Clean("/"+file.SourcePath)))
+	if err != nil || !strings.HasPrefix(sourcePath, sourceDir+string(filepath.Separator)) {
+		return nil, common.NewBadRequestError(fmt.Errorf("file %s: source %s not found", file.Path, file.SourcePath))
+	}
+
+	content, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return nil, common.NewBadRequestError(fmt.Errorf("file %s: failed to read source %s", file.Path, file.SourcePath))
+	}
+
+	return content, nil
+}
+
+// copyFilesToContainer writes the files of a sandbox into its container. Missing parent
+// directories are created and the files are owned by the user of the image.
+// Files are copied to their nearest existing directory rather than to / so that files on
+// volumes can be written when the root filesystem is read-only.
+// File contents may be secrets and are never logged.
+func (d *DockerClient) copyFilesToContainer(ctx context.Context, containerId string, files []dto.FileDTO) error {
+	filesByDir := map[string][]dto.FileDTO{}
+	existingDirs := map[string]string{}
+
+	for _, file := range files {
+		parentDir := path.Dir(path.Clean(file.Path))
+
+		dir, ok := existingDirs[parentDir]
+		if !ok {
+			var err error
+			dir, err = d.getExistingContainerDir(ctx, containerId, parentDir)
+			if err != nil {
+				return err
+			}
+			existingDirs[parentDir] = dir
+		}
+
+		filesByDir[dir] = append(filesByDir[dir], file)
+	}
+
+	dirs := make([]string, 0, len(filesByDir))
+	for dir := range filesByDir {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		err := d.copyFilesToContainerDir(ctx, containerId, dir, filesByDir[dir])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getExistingContainerDir returns dir or its nearest ancestor that exists in the container
+func (d *DockerClient) getExistingContainerDir(ctx context.Context, containerId string, dir string) (string, error) {
+	for {
+		_, err := d.apiClient.ContainerStatPath(ctx, containerId, dir)
+		if err == nil || dir == "/" {
+			return dir, nil
+		}
+
+		if !errdefs.IsNotFound(err) {
+			return "", fmt.Errorf("failed to check %s in sandbox: %w", dir, err)
+		}
+
+		dir = path.Dir(dir)
+	}
+}
+
+func (d *DockerClient) copyFilesToContainerDir(ctx context.Context, containerId string, dir string, files []dto.FileDTO) error {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	for _, file := range files {
+		content, err := d.getFileContent(file)
+		if err != nil {
+			return err
+		}
+
+		mode := int64(file.Mode)
+		if mode == 0 {
+			mode = defaultFileMode
+		}
+
+		name := strings.TrimPrefix(strings.TrimPrefix(path.Clean(file.Path), dir), "/")
+
+		err = tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    mode,
+			Size:    int64(len(content)),
+			ModTime: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tw.Write(content)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tw.Close()
+	if err != nil {
+		return err
+	}
+
+	err = d.apiClient.CopyToContainer(ctx, containerId, dir, &buf, container.CopyToContainerOptions{
+		CopyUIDGID: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy files to %s in sandbox: %w", dir, err)
+	}
+
+	return nil
+}
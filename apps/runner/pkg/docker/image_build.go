@@ -15,12 +15,19 @@ import (
 	"github.com/daytonaio/runner/cmd/runner/config"
 	"github.com/daytonaio/runner/pkg/api/dto"
 	"github.com/daytonaio/runner/pkg/storage"
+	"github.com/daytonaio/runner/pkg/tracing"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/pkg/jsonmessage"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (d *DockerClient) BuildImage(ctx context.Context, buildImageDto dto.BuildImageRequestDTO) error {
+func (d *DockerClient) BuildImage(ctx context.Context, buildImageDto dto.BuildImageRequestDTO) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "image.build", attribute.String("image", buildImageDto.Image))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	if !strings.Contains(buildImageDto.Image, ":") || strings.HasSuffix(buildImageDto.Image, ":") {
 		return fmt.Errorf("invalid image format: must contain exactly one colon (e.g., 'myimage:1.0')")
 	}
@@ -29,16 +36,18 @@ func (d *DockerClient) BuildImage(ctx context.Context, buildImageDto dto.BuildIm
 		d.logWriter.Write([]byte("Building image...\n"))
 	}
 
-	// Check if image already exists
-	exists, err := d.ImageExists(ctx, buildImageDto.Image, true)
-	if err != nil {
-		return fmt.Errorf("failed to check if image exists: %w", err)
-	}
-	if exists {
-		if d.logWriter != nil {
-			d.logWriter.Write([]byte("Image already built\n"))
+	// Check if image already exists, unless a fresh build was requested
+	if !buildImageDto.NoCache {
+		exists, err := d.ImageExists(ctx, buildImageDto.Image, true)
+		if err != nil {
+			return fmt.Errorf("failed to check if image exists: %w", err)
+		}
+		if exists {
+			if d.logWriter != nil {
+				d.logWriter.Write([]byte("Image already built\n"))
+			}
+			return nil
 		}
-		return nil
 	}
 
 	// Create a build context from the provided hashes
@@ -147,6 +156,7 @@ func (d *DockerClient) BuildImage(ctx context.Context, buildImageDto dto.BuildIm
 		Remove:      true,
 		ForceRemove: true,
 		PullParent:  true,
+		NoCache:     buildImageDto.NoCache,
 		Platform:    "linux/amd64", // Force AMD64 architecture
 	})
 	if err != nil {
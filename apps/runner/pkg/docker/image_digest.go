@@ -0,0 +1,65 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/distribution/reference"
+)
+
+// getImageDigest returns the digest an image reference is pinned to (image@sha256:...).
+// References without a digest return an empty string.
+func getImageDigest(imageName string) (string, error) {
+	if !strings.Contains(imageName, "@") {
+		return "", nil
+	}
+
+	ref, err := reference.Parse(imageName)
+	if err != nil {
+		return "", common.NewBadRequestError(fmt.Errorf("invalid image digest in %s: %w", imageName, err))
+	}
+
+	digested, ok := ref.(reference.Digested)
+	if !ok {
+		return "", common.NewBadRequestError(fmt.Errorf("invalid image digest in %s", imageName))
+	}
+
+	return digested.Digest().String(), nil
+}
+
+// verifyImageDigest makes sure the pulled image matches the digest it was requested with
+func (d *DockerClient) verifyImageDigest(ctx context.Context, imageName string, digest string) error {
+	inspect, _, err := d.apiClient.ImageInspectWithRaw(ctx, imageName)
+	if err != nil {
+		return fmt.Errorf("failed to inspect image: %w", err)
+	}
+
+	for _, repoDigest := range inspect.RepoDigests {
+		if strings.HasSuffix(repoDigest, "@"+digest) {
+			return nil
+		}
+	}
+
+	return common.NewConflictError(fmt.Errorf("image %s does not match requested digest %s (got %s)", imageName, digest, strings.Join(inspect.RepoDigests, ", ")))
+}
+
+// trimImageTag removes the tag from a digest pinned reference (repo:tag@sha256:...),
+// which is how Docker reports repository digests
+func trimImageTag(imageName string) string {
+	at := strings.LastIndex(imageName, "@")
+	if at == -1 {
+		return imageName
+	}
+
+	repository := imageName[:at]
+	if colon := strings.LastIndex(repository, ":"); colon > strings.LastIndex(repository, "/") {
+		repository = repository[:colon]
+	}
+
+	return repository + imageName[at:]
+}
@@ -26,6 +26,16 @@ func (d *DockerClient) ImageExists(ctx context.Context, imageName string, includ
 
 	found := false
 	for _, image := range images {
+		if strings.Contains(imageName, "@") {
+			for _, repoDigest := range image.RepoDigests {
+				if strings.Replace(repoDigest, "docker.io/", "", 1) == trimImageTag(imageName) {
+					found = true
+					break
+				}
+			}
+			continue
+		}
+
 		for _, tag := range image.RepoTags {
 			if strings.HasPrefix(tag, imageName) {
 				found = true
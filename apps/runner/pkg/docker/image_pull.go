@@ -7,29 +7,82 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/runner/internal/constants"
 	"github.com/daytonaio/runner/internal/util"
 	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
 	"github.com/daytonaio/runner/pkg/models/enums"
+	"github.com/daytonaio/runner/pkg/tracing"
 
+	"github.com/distribution/reference"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/pkg/jsonmessage"
+	"go.opentelemetry.io/otel/attribute"
 
 	log "github.com/sirupsen/logrus"
 )
 
+const dockerHubDomain = "docker.io"
+
+type ImagePullPolicy string
+
+const (
+	// ImagePullPolicyAlways pulls the image even if it is present locally
+	ImagePullPolicyAlways ImagePullPolicy = "always"
+	// ImagePullPolicyIfNotPresent only pulls the image if it is not present locally
+	ImagePullPolicyIfNotPresent ImagePullPolicy = "if-not-present"
+	// ImagePullPolicyNever never pulls the image and fails if it is not present locally
+	ImagePullPolicyNever ImagePullPolicy = "never"
+)
+
+func validateImagePullPolicy(policy string) error {
+	switch ImagePullPolicy(policy) {
+	case "", ImagePullPolicyAlways, ImagePullPolicyIfNotPresent, ImagePullPolicyNever:
+		return nil
+	}
+
+	return common.NewBadRequestError(fmt.Errorf("invalid image pull policy %q: must be one of always, if-not-present or never", policy))
+}
+
 func (d *DockerClient) PullImage(ctx context.Context, imageName string, reg *dto.RegistryDTO) error {
+	err := d.pullImageWithPolicy(ctx, imageName, reg, "")
+	return d.wrapDiskFullError(ctx, err)
+}
+
+// pullImageWithPolicy pulls the image according to the pull policy. Without a policy, images tagged
+// latest are always pulled and other images only if they are not present locally.
+func (d *DockerClient) pullImageWithPolicy(ctx context.Context, imageName string, reg *dto.RegistryDTO, policy ImagePullPolicy) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "image.pull", attribute.String("image", imageName))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	digest, err := getImageDigest(imageName)
+	if err != nil {
+		return err
+	}
+
 	tag := "latest"
 	lastColonIndex := strings.LastIndex(imageName, ":")
 	if lastColonIndex != -1 {
 		tag = imageName[lastColonIndex+1:]
 	}
 
-	if tag != "latest" {
+	if policy == "" {
+		// Digest pinned images are immutable, so an existing local copy can always be reused
+		policy = ImagePullPolicyAlways
+		if tag != "latest" || digest != "" {
+			policy = ImagePullPolicyIfNotPresent
+		}
+	}
+
+	if policy != ImagePullPolicyAlways {
 		exists, err := d.ImageExists(ctx, imageName, true)
 		if err != nil {
 			return err
@@ -38,10 +91,16 @@ func (d *DockerClient) PullImage(ctx context.Context, imageName string, reg *dto
 		if exists {
 			return nil
 		}
+
+		if policy == ImagePullPolicyNever {
+			return common.NewBadRequestError(fmt.Errorf("image %s is not present on the runner and the image pull policy is never", imageName))
+		}
 	}
 
 	log.Infof("Pulling image %s...", imageName)
 
+	startTime := time.Now()
+
 	sandboxIdValue := ctx.Value(constants.ID_KEY)
 
 	if sandboxIdValue != nil {
@@ -49,24 +108,107 @@ func (d *DockerClient) PullImage(ctx context.Context, imageName string, reg *dto
 		d.cache.SetSandboxState(ctx, sandboxId, enums.SandboxStatePullingImage)
 	}
 
+	// Mirrors are tried in order, the origin registry is the last resort.
+	// Images pulled with registry credentials are private, so they are only pulled from their origin.
+	pulled := false
+	if reg == nil {
+		for _, mirrorImageName := range d.getMirrorImageNames(imageName) {
+			err = d.pullImageFromMirror(ctx, imageName, mirrorImageName)
+			if err == nil {
+				log.Infof("Image %s pulled from mirror %s", imageName, mirrorImageName)
+				pulled = true
+				break
+			}
+
+			log.Warnf("Failed to pull image %s from mirror %s, trying the next source: %v", imageName, mirrorImageName, err)
+		}
+	}
+
+	if !pulled {
+		err = d.pullImage(ctx, imageName, getRegistryAuth(reg))
+		if err != nil {
+			return err
+		}
+	}
+
+	common.ImagePullDuration.Observe(time.Since(startTime).Seconds())
+
+	if digest != "" {
+		err = d.verifyImageDigest(ctx, imageName, digest)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Image %s pulled successfully", imageName)
+
+	return nil
+}
+
+func (d *DockerClient) pullImage(ctx context.Context, imageName string, registryAuth string) error {
 	responseBody, err := d.apiClient.ImagePull(ctx, imageName, image.PullOptions{
-		RegistryAuth: getRegistryAuth(reg),
+		RegistryAuth: registryAuth,
 	})
 	if err != nil {
 		return err
 	}
 	defer responseBody.Close()
 
-	err = jsonmessage.DisplayJSONMessagesStream(responseBody, io.Writer(&util.DebugLogWriter{}), 0, true, nil)
+	return jsonmessage.DisplayJSONMessagesStream(responseBody, io.Writer(&util.DebugLogWriter{}), 0, true, nil)
+}
+
+// pullImageFromMirror pulls the image from a mirror and retags it to its original name.
+// The mirror tag is removed afterwards so the image is only listed under its original name.
+func (d *DockerClient) pullImageFromMirror(ctx context.Context, imageName string, mirrorImageName string) error {
+	err := d.pullImage(ctx, mirrorImageName, "empty")
 	if err != nil {
 		return err
 	}
 
-	log.Infof("Image %s pulled successfully", imageName)
+	err = d.apiClient.ImageTag(ctx, mirrorImageName, imageName)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.apiClient.ImageRemove(ctx, mirrorImageName, image.RemoveOptions{})
+	if err != nil {
+		log.Warnf("Failed to remove mirror tag %s: %v", mirrorImageName, err)
+	}
 
 	return nil
 }
 
+// getMirrorImageNames rewrites a Docker Hub image to each configured registry mirror.
+// Images from other registries and digest pinned images are only pulled from their origin,
+// since a pinned image pulled from a mirror can't be referenced by its original name.
+func (d *DockerClient) getMirrorImageNames(imageName string) []string {
+	if len(d.registryMirrors) == 0 {
+		return nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil || reference.Domain(named) != dockerHubDomain {
+		return nil
+	}
+
+	if _, ok := named.(reference.Digested); ok {
+		return nil
+	}
+
+	tag := "latest"
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+
+	mirrorImageNames := make([]string, 0, len(d.registryMirrors))
+	for _, mirror := range d.registryMirrors {
+		mirror = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(mirror, "https://"), "http://"), "/")
+		mirrorImageNames = append(mirrorImageNames, fmt.Sprintf("%s/%s:%s", mirror, reference.Path(named), tag))
+	}
+
+	return mirrorImageNames
+}
+
 func getRegistryAuth(reg *dto.RegistryDTO) string {
 	if reg == nil {
 		// Sometimes registry auth fails if "" is sent, so sending "empty" instead
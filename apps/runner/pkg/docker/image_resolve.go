@@ -0,0 +1,47 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+)
+
+type ResolvedImage struct {
+	// Image reference the container was created from
+	Image string
+	// Local ID of the image the container runs
+	ImageId string
+	// Registry digest of the image, empty for images that were built locally
+	Digest string
+}
+
+// ResolveSandboxImage returns the image a sandbox container is actually running,
+// which may differ from the requested image after a build or a tag update
+func (d *DockerClient) ResolveSandboxImage(ctx context.Context, container types.ContainerJSON) (*ResolvedImage, error) {
+	resolved := &ResolvedImage{
+		ImageId: container.Image,
+	}
+	if container.Config != nil {
+		resolved.Image = container.Config.Image
+	}
+
+	inspect, _, err := d.apiClient.ImageInspectWithRaw(ctx, container.Image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect image: %w", err)
+	}
+
+	for _, repoDigest := range inspect.RepoDigests {
+		_, digest, found := strings.Cut(repoDigest, "@")
+		if found {
+			resolved.Digest = digest
+			break
+		}
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Number of log lines of a failed init step included in the error
+const initStepLogTail = "50"
+
+// Maximum number of processes in an init step container
+const initStepPidsLimit = 4096
+
+// initStepExitError is returned when an init step ran but exited with a non-zero code
+type initStepExitError struct {
+	exitCode int64
+	logs     string
+}
+
+func (e *initStepExitError) Error() string {
+	return fmt.Sprintf("exited with code %d:\n%s", e.exitCode, e.logs)
+}
+
+func validateInitSteps(initSteps []dto.InitStepDTO) error {
+	for i, step := range initSteps {
+		if step.Image == "" {
+			return common.NewBadRequestError(fmt.Errorf("init step %d: image is required", i+1))
+		}
+
+		_, err := getImageDigest(step.Image)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runInitSteps runs the init steps of a sandbox in order before its container is started.
+// Each step runs in its own container that shares the volumes of the sandbox container.
+func (d *DockerClient) runInitSteps(ctx context.Context, sandboxDto dto.CreateSandboxDTO, containerId string) error {
+	hostConfig, err := d.getInitStepHostConfig(ctx, sandboxDto, containerId)
+	if err != nil {
+		return err
+	}
+
+	for i, step := range sandboxDto.InitSteps {
+		log.Infof("Running init step %d of sandbox %s", i+1, sandboxDto.Id)
+
+		err := d.pullImageWithPolicy(ctx, step.Image, sandboxDto.Registry, ImagePullPolicy(sandboxDto.ImagePullPolicy))
+		if err != nil {
+			return fmt.Errorf("init step %d: failed to pull image: %w", i+1, err)
+		}
+
+		err = d.runInitStep(ctx, fmt.Sprintf("%s-init-%d", sandboxDto.Id, i+1), getInitStepLabels(sandboxDto.Id, i+1), step, hostConfig)
+		if err != nil {
+			// A failing step is a problem with the step the user provided, not with the runner
+			var exitErr *initStepExitError
+			if errors.As(err, &exitErr) {
+				return common.NewCustomError(http.StatusUnprocessableEntity, fmt.Sprintf("init step %d %s", i+1, exitErr), "INIT_STEP_FAILED")
+			}
+			return fmt.Errorf("init step %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// getInitStepHostConfig returns the host config of the init step containers of a sandbox. Steps share the
+// volumes of the sandbox and are held to its resource limits, runtime, network and security settings,
+// but never run privileged.
+func (d *DockerClient) getInitStepHostConfig(ctx context.Context, sandboxDto dto.CreateSandboxDTO, sandboxContainerId string) (*container.HostConfig, error) {
+	sandboxHostConfig, err := d.getContainerHostConfig(ctx, sandboxDto, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pidsLimit := int64(initStepPidsLimit)
+	resources := sandboxHostConfig.Resources
+	resources.PidsLimit = &pidsLimit
+
+	return &container.HostConfig{
+		VolumesFrom: []string{sandboxContainerId},
+		ExtraHosts:  sandboxHostConfig.ExtraHosts,
+		DNS:         sandboxHostConfig.DNS,
+		Resources:   resources,
+		Runtime:     sandboxHostConfig.Runtime,
+		CapAdd:      sandboxHostConfig.CapAdd,
+		CapDrop:     sandboxHostConfig.CapDrop,
+		SecurityOpt: sandboxHostConfig.SecurityOpt,
+		StorageOpt:  sandboxHostConfig.StorageOpt,
+	}, nil
+}
+
+func (d *DockerClient) runInitStep(ctx context.Context, name string, labels map[string]string, step dto.InitStepDTO, hostConfig *container.HostConfig) error {
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:      step.Image,
+		Entrypoint: step.Entrypoint,
+		Cmd:        step.Command,
+		Labels:     labels,
+	}, hostConfig, nil, nil, name)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err := d.apiClient.ContainerRemove(context.Background(), c.ID, container.RemoveOptions{Force: true})
+		if err != nil {
+			log.Warnf("Failed to remove init step container %s: %v", name, err)
+		}
+	}()
+
+	statusCh, errCh := d.apiClient.ContainerWait(ctx, c.ID, container.WaitConditionNextExit)
+
+	err = d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	case status := <-statusCh:
+		if status.StatusCode == 0 {
+			return nil
+		}
+
+		return &initStepExitError{exitCode: status.StatusCode, logs: d.getInitStepLogs(ctx, c.ID)}
+	}
+}
+
+func (d *DockerClient) getInitStepLogs(ctx context.Context, containerId string) string {
+	logs, err := d.apiClient.ContainerLogs(ctx, containerId, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       initStepLogTail,
+	})
+	if err != nil {
+		return fmt.Sprintf("failed to read logs: %v", err)
+	}
+	defer logs.Close()
+
+	var output bytes.Buffer
+	_, err = stdcopy.StdCopy(&output, &output, logs)
+	if err != nil {
+		return fmt.Sprintf("failed to read logs: %v", err)
+	}
+
+	return strings.TrimSpace(output.String())
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/volume"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Labels set on the containers created by the runner, used to find them without relying on their names
+const (
+	LabelSandboxId = "daytona.sandbox.id"
+	LabelRole      = "daytona.role"
+	// Index of the init step, starting at 1
+	LabelInitStep = "daytona.init-step"
+	// Set on sandboxes created without being started
+	LabelNoStart = "daytona.no-start"
+
+	RoleSandbox  = "sandbox"
+	RoleInitStep = "init-step"
+	// Volume of the home directory of sandboxes with a read-only root filesystem
+	RoleHome = "home"
+)
+
+func getSandboxLabels(sandboxDto dto.CreateSandboxDTO) map[string]string {
+	labels := map[string]string{
+		LabelSandboxId: sandboxDto.Id,
+		LabelRole:      RoleSandbox,
+	}
+
+	if sandboxDto.NoStart {
+		labels[LabelNoStart] = "true"
+	}
+
+	return labels
+}
+
+func getInitStepLabels(sandboxId string, step int) map[string]string {
+	return map[string]string{
+		LabelSandboxId: sandboxId,
+		LabelRole:      RoleInitStep,
+		LabelInitStep:  strconv.Itoa(step),
+	}
+}
+
+func getHomeVolumeLabels(sandboxId string) map[string]string {
+	return map[string]string{
+		LabelSandboxId: sandboxId,
+		LabelRole:      RoleHome,
+	}
+}
+
+// removeInitStepContainers removes init step containers of the sandbox that were left behind,
+// e.g. when the runner stopped while an init step was running
+func (d *DockerClient) removeInitStepContainers(ctx context.Context, sandboxId string) {
+	containers, err := d.apiClient.ContainerList(ctx, container.ListOptions{
+		All: true,
+		Filters: filters.NewArgs(
+			filters.Arg("label", LabelSandboxId+"="+sandboxId),
+			filters.Arg("label", LabelRole+"="+RoleInitStep),
+		),
+	})
+	if err != nil {
+		log.Warnf("Failed to list init step containers of sandbox %s: %v", sandboxId, err)
+		return
+	}
+
+	for _, c := range containers {
+		err := d.apiClient.ContainerRemove(ctx, c.ID, container.RemoveOptions{Force: true})
+		if err != nil {
+			log.Warnf("Failed to remove init step container %s of sandbox %s: %v", c.ID, sandboxId, err)
+		}
+	}
+}
+
+// removeHomeVolumes removes the home directory volume of a destroyed sandbox. Other volumes, e.g. the ones
+// declared by the image, are left to the operator like for any other container.
+func (d *DockerClient) removeHomeVolumes(ctx context.Context, sandboxId string) {
+	volumes, err := d.apiClient.VolumeList(ctx, volume.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("label", LabelSandboxId+"="+sandboxId),
+			filters.Arg("label", LabelRole+"="+RoleHome),
+		),
+	})
+	if err != nil {
+		log.Warnf("Failed to list home volumes of sandbox %s: %v", sandboxId, err)
+		return
+	}
+
+	for _, v := range volumes.Volumes {
+		err := d.apiClient.VolumeRemove(ctx, v.Name, true)
+		if err != nil {
+			log.Warnf("Failed to remove home volume %s of sandbox %s: %v", v.Name, sandboxId, err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/runner/pkg/cache"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// fakeDockerAPI serves containers from memory, calls to other methods of the interface panic
+type fakeDockerAPI struct {
+	client.APIClient
+	containers map[string]types.ContainerJSON
+	stopErr    error
+}
+
+func (f *fakeDockerAPI) ContainerList(ctx context.Context, options container.ListOptions) ([]types.Container, error) {
+	containers := make([]types.Container, 0, len(f.containers))
+	for name, c := range f.containers {
+		containers = append(containers, types.Container{
+			ID:     c.ID,
+			Names:  []string{"/" + name},
+			Labels: c.Config.Labels,
+		})
+	}
+	return containers, nil
+}
+
+func (f *fakeDockerAPI) ContainerInspect(ctx context.Context, containerId string) (types.ContainerJSON, error) {
+	c, ok := f.containers[containerId]
+	if !ok {
+		return types.ContainerJSON{}, errdefs.NotFound(errors.New("no such container"))
+	}
+	return c, nil
+}
+
+func (f *fakeDockerAPI) ContainerLogs(ctx context.Context, containerId string, options container.LogsOptions) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (f *fakeDockerAPI) ContainerStop(ctx context.Context, containerId string, options container.StopOptions) error {
+	if f.stopErr != nil {
+		return f.stopErr
+	}
+	c := f.containers[containerId]
+	c.State.Status = "exited"
+	c.State.Running = false
+	return nil
+}
+
+func newFakeContainer(id, status string, labels map[string]string) types.ContainerJSON {
+	return types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID: id,
+			State: &types.ContainerState{
+				Status:  status,
+				Running: status == "running",
+			},
+		},
+		Config: &container.Config{Labels: labels},
+	}
+}
+
+func TestSeedSandboxStates(t *testing.T) {
+	common.SandboxStateCount.Reset()
+
+	api := &fakeDockerAPI{containers: map[string]types.ContainerJSON{
+		// Created before the runner labelled its containers
+		"unlabelled": newFakeContainer("unlabelled", "running", nil),
+		"labelled":   newFakeContainer("labelled", "exited", map[string]string{LabelSandboxId: "labelled", LabelRole: RoleSandbox}),
+		"labelled-init-1": newFakeContainer("labelled-init-1", "exited", map[string]string{
+			LabelSandboxId: "labelled",
+			LabelRole:      RoleInitStep,
+		}),
+	}}
+	d := NewDockerClient(DockerClientConfig{
+		ApiClient: api,
+		Cache:     cache.NewInMemoryRunnerCache(cache.InMemoryRunnerCacheConfig{}),
+	})
+
+	d.SeedSandboxStates(context.Background())
+
+	if got := testutil.ToFloat64(common.SandboxStateCount.WithLabelValues("started")); got != 1 {
+		t.Errorf("started sandboxes = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(common.SandboxStateCount.WithLabelValues("stopped")); got != 1 {
+		t.Errorf("stopped sandboxes = %v, want 1", got)
+	}
+}
+
+func TestStopCountsOperation(t *testing.T) {
+	tests := []struct {
+		name    string
+		stopErr error
+		status  common.PrometheusOperationStatus
+	}{
+		{
+			name:   "stopped",
+			status: common.PrometheusOperationStatusSuccess,
+		},
+		{
+			name:    "stop failed",
+			stopErr: errors.New("stop failed"),
+			status:  common.PrometheusOperationStatusFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			common.ContainerOperationCount.Reset()
+
+			api := &fakeDockerAPI{
+				containers: map[string]types.ContainerJSON{"sandbox": newFakeContainer("sandbox", "running", nil)},
+				stopErr:    tt.stopErr,
+			}
+			d := NewDockerClient(DockerClientConfig{
+				ApiClient: api,
+				Cache:     cache.NewInMemoryRunnerCache(cache.InMemoryRunnerCacheConfig{}),
+			})
+
+			gracePeriod := time.Duration(0)
+			err := d.Stop(context.Background(), "sandbox", &gracePeriod)
+			if (err != nil) != (tt.stopErr != nil) {
+				t.Fatalf("Stop() error = %v, want error %v", err, tt.stopErr)
+			}
+
+			if got := testutil.ToFloat64(common.ContainerOperationCount.WithLabelValues("stop", string(tt.status))); got != 1 {
+				t.Errorf("stop operations with status %s = %v, want 1", tt.status, got)
+			}
+			if got := testutil.CollectAndCount(common.ContainerOperationCount); got != 1 {
+				t.Errorf("stop operation series = %d, want 1", got)
+			}
+		})
+	}
+}
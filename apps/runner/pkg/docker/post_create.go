@@ -0,0 +1,56 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types/container"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func validatePostCreateCommands(commands []string) error {
+	for i, command := range commands {
+		if strings.TrimSpace(command) == "" {
+			return common.NewBadRequestError(fmt.Errorf("post-create command %d is empty", i+1))
+		}
+	}
+
+	return nil
+}
+
+// runPostCreateCommands runs the post-create commands of a sandbox in order with sh in the home
+// directory of the sandbox user, once its container has started for the first time.
+// A failing command fails the creation unless PostCreateContinueOnError is set.
+func (d *DockerClient) runPostCreateCommands(ctx context.Context, sandboxDto dto.CreateSandboxDTO, containerId string) error {
+	for i, command := range sandboxDto.PostCreateCommands {
+		log.Infof("Running post-create command %d of sandbox %s: %s", i+1, sandboxDto.Id, command)
+
+		result, err := d.execSync(ctx, containerId, container.ExecOptions{
+			Cmd:          []string{"sh", "-c", command},
+			WorkingDir:   getUserHomeDir(sandboxDto.OsUser),
+			AttachStdout: true,
+			AttachStderr: true,
+		}, container.ExecStartOptions{})
+		if err == nil && result.ExitCode != 0 {
+			err = fmt.Errorf("exited with code %d: %s", result.ExitCode, strings.TrimSpace(result.StdErr+result.StdOut))
+		}
+
+		if err != nil {
+			if sandboxDto.PostCreateContinueOnError {
+				log.Warnf("Post-create command %d of sandbox %s failed: %v", i+1, sandboxDto.Id, err)
+				continue
+			}
+
+			return fmt.Errorf("post-create command %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types/container"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultReadinessProbeRetries  = 30
+	defaultReadinessProbeInterval = 1 * time.Second
+	defaultReadinessProbeTimeout  = 1 * time.Second
+)
+
+func validateReadinessProbe(probe *dto.ReadinessProbeDTO) error {
+	if probe == nil {
+		return nil
+	}
+
+	if probe.Port == 0 && len(probe.Command) == 0 {
+		return common.NewBadRequestError(errors.New("readiness probe requires a port or a command"))
+	}
+
+	if probe.Port < 0 || probe.Port > 65535 {
+		return common.NewBadRequestError(fmt.Errorf("invalid readiness probe port %d", probe.Port))
+	}
+
+	if probe.Retries < 0 || probe.IntervalSeconds < 0 || probe.TimeoutSeconds < 0 {
+		return common.NewBadRequestError(errors.New("readiness probe retries, interval and timeout must not be negative"))
+	}
+
+	return nil
+}
+
+// waitForReadiness blocks until the readiness probe succeeds or runs out of retries.
+// A nil probe means the sandbox is ready as soon as its container has started.
+func (d *DockerClient) waitForReadiness(ctx context.Context, containerId string, probe *dto.ReadinessProbeDTO) error {
+	if probe == nil {
+		return nil
+	}
+
+	retries := defaultReadinessProbeRetries
+	if probe.Retries > 0 {
+		retries = probe.Retries
+	}
+
+	interval := defaultReadinessProbeInterval
+	if probe.IntervalSeconds > 0 {
+		interval = time.Duration(probe.IntervalSeconds) * time.Second
+	}
+
+	timeout := defaultReadinessProbeTimeout
+	if probe.TimeoutSeconds > 0 {
+		timeout = time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+
+	var lastErr error
+	for i := 0; i < retries; i++ {
+		lastErr = d.probeReadiness(ctx, containerId, probe, timeout)
+		if lastErr == nil {
+			return nil
+		}
+
+		log.Debugf("Readiness probe for sandbox %s failed (attempt %d/%d): %v", containerId, i+1, retries, lastErr)
+
+		if i == retries-1 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	log.Warnf("Sandbox %s did not become ready after %d attempts: %v", containerId, retries, lastErr)
+
+	return common.NewReadinessProbeError(fmt.Errorf("sandbox %s not ready after %d attempts: %w", containerId, retries, lastErr))
+}
+
+func (d *DockerClient) probeReadiness(ctx context.Context, containerId string, probe *dto.ReadinessProbeDTO, timeout time.Duration) error {
+	if probe.Port != 0 {
+		c, err := d.ContainerInspect(ctx, containerId)
+		if err != nil {
+			return err
+		}
+
+		var containerIP string
+		for _, network := range c.NetworkSettings.Networks {
+			containerIP = network.IPAddress
+			break
+		}
+
+		if containerIP == "" {
+			return errors.New("container has no IP address")
+		}
+
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(containerIP, fmt.Sprint(probe.Port)), timeout)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+	}
+
+	if len(probe.Command) > 0 {
+		execCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		result, err := d.execSync(execCtx, containerId, container.ExecOptions{
+			Cmd:          probe.Command,
+			AttachStdout: true,
+			AttachStderr: true,
+		}, container.ExecStartOptions{})
+		if err != nil {
+			return err
+		}
+
+		if result.ExitCode != 0 {
+			return fmt.Errorf("command exited with code %d: %s", result.ExitCode, strings.TrimSpace(result.StdErr+result.StdOut))
+		}
+	}
+
+	return nil
+}
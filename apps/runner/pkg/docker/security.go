@@ -0,0 +1,111 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types/container"
+)
+
+var capabilityRegex = regexp.MustCompile(`^(CAP_)?[A-Z_]+$`)
+
+const dockerSocketPath = "/var/run/docker.sock"
+
+// isPrivileged returns whether a sandbox runs privileged. Sandboxes that don't explicitly
+// opt in or out are privileged whenever the runner allows it, unless they request security
+// settings, which a privileged container would ignore.
+func (d *DockerClient) isPrivileged(sandboxDto dto.CreateSandboxDTO) bool {
+	if sandboxDto.Privileged == nil {
+		return d.allowPrivileged && sandboxDto.Security == nil
+	}
+
+	return *sandboxDto.Privileged
+}
+
+// validateHostAccess enforces the runner policies for privileged sandboxes and Docker socket mounts
+func (d *DockerClient) validateHostAccess(sandboxDto dto.CreateSandboxDTO) error {
+	if d.isPrivileged(sandboxDto) && !d.allowPrivileged {
+		return common.NewCustomError(http.StatusForbidden, "privileged sandboxes are not allowed on this runner", "FORBIDDEN")
+	}
+
+	if d.isPrivileged(sandboxDto) && sandboxDto.Security != nil {
+		return common.NewBadRequestError(errors.New("security settings have no effect on privileged sandboxes, set privileged to false"))
+	}
+
+	if sandboxDto.MountDockerSocket && !d.allowDockerSocket {
+		return common.NewCustomError(http.StatusForbidden, "mounting the Docker socket is not allowed on this runner", "FORBIDDEN")
+	}
+
+	return nil
+}
+
+func validateSecurityConfig(security *dto.SecurityDTO) error {
+	if security == nil {
+		return nil
+	}
+
+	for _, capability := range append(append([]string{}, security.CapAdd...), security.CapDrop...) {
+		if capability != "ALL" && !capabilityRegex.MatchString(capability) {
+			return common.NewBadRequestError(fmt.Errorf("invalid capability %q", capability))
+		}
+	}
+
+	if strings.ContainsAny(security.AppArmorProfile, " \t\n") {
+		return common.NewBadRequestError(fmt.Errorf("invalid AppArmor profile %q", security.AppArmorProfile))
+	}
+
+	return nil
+}
+
+// applySecurityConfig sets the security options and capabilities of a sandbox on its host config
+func applySecurityConfig(hostConfig *container.HostConfig, security *dto.SecurityDTO) error {
+	if security == nil {
+		return nil
+	}
+
+	hostConfig.CapAdd = security.CapAdd
+	hostConfig.CapDrop = security.CapDrop
+
+	if security.AppArmorProfile != "" {
+		hostConfig.SecurityOpt = append(hostConfig.SecurityOpt, "apparmor="+security.AppArmorProfile)
+	}
+
+	if security.SeccompProfile != "" {
+		seccompProfile, err := readSeccompProfile(security.SeccompProfile)
+		if err != nil {
+			return err
+		}
+		hostConfig.SecurityOpt = append(hostConfig.SecurityOpt, "seccomp="+seccompProfile)
+	}
+
+	return nil
+}
+
+// readSeccompProfile returns the content of a seccomp profile, since the Docker API
+// expects the profile itself rather than a path
+func readSeccompProfile(profile string) (string, error) {
+	if profile == "unconfined" {
+		return profile, nil
+	}
+
+	content, err := os.ReadFile(profile)
+	if err != nil {
+		return "", common.NewBadRequestError(fmt.Errorf("failed to read seccomp profile: %w", err))
+	}
+
+	if !json.Valid(content) {
+		return "", common.NewBadRequestError(fmt.Errorf("seccomp profile %s is not valid JSON", profile))
+	}
+
+	return string(content), nil
+}
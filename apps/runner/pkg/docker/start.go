@@ -8,13 +8,38 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/daytonaio/runner/pkg/common"
 	"github.com/daytonaio/runner/pkg/models/enums"
+	"github.com/daytonaio/runner/pkg/tracing"
 	"github.com/docker/docker/api/types/container"
+	"go.opentelemetry.io/otel/attribute"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func (d *DockerClient) Start(ctx context.Context, containerId string) error {
+func (d *DockerClient) Start(ctx context.Context, containerId string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "sandbox.start", attribute.String("sandbox.id", containerId))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	startTime := time.Now()
+	defer func() {
+		obs, err := common.ContainerOperationDuration.GetMetricWithLabelValues("start")
+		if err == nil {
+			obs.Observe(time.Since(startTime).Seconds())
+		}
+	}()
+
+	// Counted here rather than in the controller, since creating a sandbox also starts it
+	defer func() {
+		status := common.PrometheusOperationStatusSuccess
+		if err != nil {
+			status = common.PrometheusOperationStatusFailure
+		}
+		common.ContainerOperationCount.WithLabelValues("start", string(status)).Inc()
+	}()
+
 	d.cache.SetSandboxState(ctx, containerId, enums.SandboxStateStarting)
 
 	c, err := d.ContainerInspect(ctx, containerId)
@@ -27,6 +52,10 @@ func (d *DockerClient) Start(ctx context.Context, containerId string) error {
 		return nil
 	}
 
+	// Docker reports the zero time for containers that have never been started
+	startedAt, _ := time.Parse(time.RFC3339Nano, c.State.StartedAt)
+	firstStart := startedAt.IsZero()
+
 	err = d.apiClient.ContainerStart(ctx, containerId, container.StartOptions{})
 	if err != nil {
 		return err
@@ -38,6 +67,11 @@ func (d *DockerClient) Start(ctx context.Context, containerId string) error {
 		return err
 	}
 
+	// Sandboxes created with no-start are first started here instead of during create
+	if firstStart && c.Config != nil {
+		d.setLocaltime(ctx, containerId, c.Config.Env)
+	}
+
 	d.cache.SetSandboxState(ctx, containerId, enums.SandboxStateStarted)
 
 	processesCtx := context.Background()
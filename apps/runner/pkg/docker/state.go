@@ -11,6 +11,8 @@ import (
 	"github.com/daytonaio/runner/pkg/models/enums"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
+
+	log "github.com/sirupsen/logrus"
 )
 
 func (d *DockerClient) DeduceSandboxState(ctx context.Context, sandboxId string) (enums.SandboxState, error) {
@@ -28,6 +30,10 @@ func (d *DockerClient) DeduceSandboxState(ctx context.Context, sandboxId string)
 
 	switch container.State.Status {
 	case "created":
+		// Sandboxes created without being started stay stopped until started
+		if container.Config != nil && container.Config.Labels[LabelNoStart] == "true" {
+			return enums.SandboxStateStopped, nil
+		}
 		return enums.SandboxStateCreating, nil
 
 	case "running":
@@ -60,6 +66,32 @@ func (d *DockerClient) DeduceSandboxState(ctx context.Context, sandboxId string)
 	}
 }
 
+// SeedSandboxStates adds the sandboxes that exist when the runner starts to the cache,
+// so that they are counted in the sandbox state gauge before they are next queried.
+// Sandbox containers are named by the sandbox ID, containers created before the runner
+// labelled them are included, only containers labelled with another role are skipped.
+func (d *DockerClient) SeedSandboxStates(ctx context.Context) {
+	containers, err := d.apiClient.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		log.Warnf("Failed to list sandbox containers: %v", err)
+		return
+	}
+
+	for _, c := range containers {
+		if role, ok := c.Labels[LabelRole]; ok && role != RoleSandbox {
+			continue
+		}
+		if len(c.Names) == 0 {
+			continue
+		}
+		sandboxId := strings.TrimPrefix(c.Names[0], "/")
+
+		// Errors are reported as the error state, which is what the gauge should count
+		state, _ := d.DeduceSandboxState(ctx, sandboxId)
+		d.cache.SetSandboxState(ctx, sandboxId, state)
+	}
+}
+
 // isContainerPullingImage checks if the container is still in image pulling phase
 func (d *DockerClient) isContainerPullingImage(containerId string) bool {
 	options := container.LogsOptions{
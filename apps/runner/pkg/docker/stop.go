@@ -8,21 +8,72 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/daytonaio/runner/pkg/common"
 	"github.com/daytonaio/runner/pkg/models/enums"
+	"github.com/daytonaio/runner/pkg/tracing"
 	"github.com/docker/docker/api/types/container"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (d *DockerClient) Stop(ctx context.Context, containerId string) error {
+// Stop stops a sandbox container. gracePeriod overrides the stop grace period of the sandbox if set.
+// Without a grace period the container is killed immediately, otherwise it is sent SIGTERM
+// and killed once the grace period has passed.
+func (d *DockerClient) Stop(ctx context.Context, containerId string, gracePeriod *time.Duration) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "sandbox.stop", attribute.String("sandbox.id", containerId))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	startTime := time.Now()
+	defer func() {
+		obs, err := common.ContainerOperationDuration.GetMetricWithLabelValues("stop")
+		if err == nil {
+			obs.Observe(time.Since(startTime).Seconds())
+		}
+	}()
+
+	defer func() {
+		status := common.PrometheusOperationStatusSuccess
+		if err != nil {
+			status = common.PrometheusOperationStatusFailure
+		}
+		common.ContainerOperationCount.WithLabelValues("stop", string(status)).Inc()
+	}()
+
 	d.cache.SetSandboxState(ctx, containerId, enums.SandboxStateStopping)
 
-	err := d.apiClient.ContainerStop(ctx, containerId, container.StopOptions{
+	stopOptions := container.StopOptions{
 		Signal: "SIGKILL",
-	})
+	}
+
+	if gracePeriod == nil {
+		c, err := d.ContainerInspect(ctx, containerId)
+		if err != nil {
+			return err
+		}
+
+		if c.Config != nil && c.Config.StopTimeout != nil {
+			timeout := time.Duration(*c.Config.StopTimeout) * time.Second
+			gracePeriod = &timeout
+		}
+	}
+
+	waitTimeout := 10 * time.Second
+	if gracePeriod != nil && *gracePeriod > 0 {
+		timeout := int(gracePeriod.Seconds())
+		stopOptions = container.StopOptions{
+			Signal:  "SIGTERM",
+			Timeout: &timeout,
+		}
+		waitTimeout += *gracePeriod
+	}
+
+	err = d.apiClient.ContainerStop(ctx, containerId, stopOptions)
 	if err != nil {
 		return err
 	}
 
-	err = d.waitForContainerStopped(ctx, containerId, 10*time.Second)
+	err = d.waitForContainerStopped(ctx, containerId, waitTimeout)
 	if err != nil {
 		return err
 	}
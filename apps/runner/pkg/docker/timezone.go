@@ -0,0 +1,50 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var timezoneRegex = regexp.MustCompile(`^[A-Za-z0-9_+\-]+(/[A-Za-z0-9_+\-]+)*$`)
+
+// Points /etc/localtime at the zone passed as $1 if the image ships the zoneinfo database
+const setLocaltimeScript = `[ -f "/usr/share/zoneinfo/$1" ] || exit 0
+ln -sf "/usr/share/zoneinfo/$1" /etc/localtime && echo "$1" > /etc/timezone`
+
+// setLocaltime configures /etc/localtime of a sandbox for the timezone in its TZ environment variable,
+// for programs that don't read TZ. Images without the zoneinfo database are left as they are.
+func (d *DockerClient) setLocaltime(ctx context.Context, containerId string, env []string) {
+	tz := ""
+	for _, envVar := range env {
+		if value, ok := strings.CutPrefix(envVar, "TZ="); ok {
+			tz = strings.TrimPrefix(value, ":")
+		}
+	}
+
+	if tz == "" || !timezoneRegex.MatchString(tz) {
+		return
+	}
+
+	result, err := d.execSync(ctx, containerId, container.ExecOptions{
+		Cmd:          []string{"sh", "-c", setLocaltimeScript, "sh", tz},
+		User:         "root",
+		AttachStdout: true,
+		AttachStderr: true,
+	}, container.ExecStartOptions{})
+	if err == nil && result.ExitCode != 0 {
+		err = fmt.Errorf("exited with code %d: %s", result.ExitCode, strings.TrimSpace(result.StdErr+result.StdOut))
+	}
+
+	if err != nil {
+		log.Warnf("Failed to set the timezone of sandbox %s to %s: %v", containerId, tz, err)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package docker
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/runner/pkg/api/dto"
+	"github.com/daytonaio/runner/pkg/common"
+	"github.com/docker/docker/api/types/container"
+)
+
+var ulimitNames = []string{
+	"core", "cpu", "data", "fsize", "locks", "memlock", "msgqueue", "nice",
+	"nofile", "nproc", "rss", "rtprio", "rttime", "sigpending", "stack",
+}
+
+// Large builds and language servers easily exceed the usual default of 1024 open files
+var defaultUlimits = []*container.Ulimit{
+	{Name: "nofile", Soft: 65536, Hard: 1048576},
+}
+
+func validateUlimits(ulimits []dto.UlimitDTO) error {
+	seen := make(map[string]bool, len(ulimits))
+
+	for _, ulimit := range ulimits {
+		if !slices.Contains(ulimitNames, ulimit.Name) {
+			return common.NewBadRequestError(fmt.Errorf("invalid ulimit %q: must be one of %v", ulimit.Name, ulimitNames))
+		}
+
+		if seen[ulimit.Name] {
+			return common.NewBadRequestError(fmt.Errorf("ulimit %s is set more than once", ulimit.Name))
+		}
+		seen[ulimit.Name] = true
+
+		if ulimit.Soft < 0 || ulimit.Hard < 0 {
+			return common.NewBadRequestError(fmt.Errorf("invalid ulimit %s: limits must not be negative", ulimit.Name))
+		}
+
+		if ulimit.Soft > ulimit.Hard {
+			return common.NewBadRequestError(fmt.Errorf("invalid ulimit %s: soft limit %d is greater than hard limit %d", ulimit.Name, ulimit.Soft, ulimit.Hard))
+		}
+	}
+
+	return nil
+}
+
+// getUlimits returns the requested ulimits merged over the defaults
+func getUlimits(ulimits []dto.UlimitDTO) []*container.Ulimit {
+	result := make([]*container.Ulimit, 0, len(defaultUlimits)+len(ulimits))
+
+	for _, ulimit := range defaultUlimits {
+		if !slices.ContainsFunc(ulimits, func(u dto.UlimitDTO) bool { return u.Name == ulimit.Name }) {
+			result = append(result, &container.Ulimit{Name: ulimit.Name, Soft: ulimit.Soft, Hard: ulimit.Hard})
+		}
+	}
+
+	for _, ulimit := range ulimits {
+		result = append(result, &container.Ulimit{Name: ulimit.Name, Soft: ulimit.Soft, Hard: ulimit.Hard})
+	}
+
+	return result
+}
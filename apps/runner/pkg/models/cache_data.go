@@ -13,4 +13,6 @@ type CacheData struct {
 	SandboxState    enums.SandboxState
 	SnapshotState   enums.SnapshotState
 	DestructionTime *time.Time
+	// Durations of the steps of the last sandbox creation, keyed by phase
+	PhaseDurations map[string]time.Duration
 }
@@ -5,6 +5,7 @@ package runner
 
 import (
 	"log"
+	"sync"
 
 	"github.com/daytonaio/runner/pkg/cache"
 	"github.com/daytonaio/runner/pkg/docker"
@@ -23,23 +24,36 @@ type Runner struct {
 	SandboxService *services.SandboxService
 }
 
-var runner *Runner
+var (
+	runner      *Runner
+	runnerMutex sync.RWMutex
+)
 
+// GetInstance initializes the runner when called with a config and returns it otherwise.
+// Handlers call it on every request, so getting the initialized runner only takes a read lock.
 func GetInstance(config *RunnerInstanceConfig) *Runner {
-	if config != nil && runner != nil {
-		log.Fatal("Runner already initialized")
-	}
+	if config == nil {
+		runnerMutex.RLock()
+		defer runnerMutex.RUnlock()
 
-	if runner == nil {
-		if config == nil {
+		if runner == nil {
 			log.Fatal("Runner not initialized")
 		}
 
-		runner = &Runner{
-			Cache:          config.Cache,
-			Docker:         config.Docker,
-			SandboxService: config.SandboxService,
-		}
+		return runner
+	}
+
+	runnerMutex.Lock()
+	defer runnerMutex.Unlock()
+
+	if runner != nil {
+		log.Fatal("Runner already initialized")
+	}
+
+	runner = &Runner{
+		Cache:          config.Cache,
+		Docker:         config.Docker,
+		SandboxService: config.SandboxService,
 	}
 
 	return runner
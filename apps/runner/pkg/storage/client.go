@@ -10,4 +10,6 @@ import (
 // ObjectStorageClient defines the interface for object storage operations
 type ObjectStorageClient interface {
 	GetObject(ctx context.Context, organizationId, hash string) ([]byte, error)
+	// Ping checks that the storage is reachable and the bucket exists
+	Ping(ctx context.Context) error
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/daytonaio/runner/cmd/runner/config"
 	"github.com/minio/minio-go/v7"
@@ -21,13 +22,21 @@ type minioClient struct {
 	bucketName string
 }
 
-var instance ObjectStorageClient
+var (
+	instance     ObjectStorageClient
+	instanceErr  error
+	instanceOnce sync.Once
+)
 
 func GetObjectStorageClient() (ObjectStorageClient, error) {
-	if instance != nil {
-		return instance, nil
-	}
+	instanceOnce.Do(func() {
+		instance, instanceErr = newMinioClient()
+	})
 
+	return instance, instanceErr
+}
+
+func newMinioClient() (ObjectStorageClient, error) {
 	runnerConfig, err := config.GetConfig()
 	if err != nil {
 		return nil, err
@@ -57,12 +66,10 @@ func GetObjectStorageClient() (ObjectStorageClient, error) {
 		return nil, fmt.Errorf("failed to create S3 client: %w", err)
 	}
 
-	instance = &minioClient{
+	return &minioClient{
 		client:     client,
 		bucketName: bucketName,
-	}
-
-	return instance, nil
+	}, nil
 }
 
 func (m *minioClient) GetObject(ctx context.Context, organizationId, hash string) ([]byte, error) {
@@ -80,3 +87,16 @@ func (m *minioClient) GetObject(ctx context.Context, organizationId, hash string
 
 	return data, nil
 }
+
+func (m *minioClient) Ping(ctx context.Context) error {
+	exists, err := m.client.BucketExists(ctx, m.bucketName)
+	if err != nil {
+		return fmt.Errorf("failed to reach storage: %w", err)
+	}
+
+	if !exists {
+		return fmt.Errorf("bucket %s does not exist", m.bucketName)
+	}
+
+	return nil
+}
@@ -377,6 +377,21 @@ type ToolboxAPI interface {
 	// GitPushChangesExecute executes the request
 	GitPushChangesExecute(r ToolboxAPIGitPushChangesRequest) (*http.Response, error)
 
+	/*
+		GitResetRepository Reset repository
+
+		Fetch the remote and reset the current branch to its upstream branch
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param workspaceId
+		@return ToolboxAPIGitResetRepositoryRequest
+	*/
+	GitResetRepository(ctx context.Context, workspaceId string) ToolboxAPIGitResetRepositoryRequest
+
+	// GitResetRepositoryExecute executes the request
+	//  @return GitResetResponse
+	GitResetRepositoryExecute(r ToolboxAPIGitResetRepositoryRequest) (*GitResetResponse, *http.Response, error)
+
 	/*
 		ListFiles List files
 
@@ -3459,6 +3474,131 @@ func (a *ToolboxAPIService) GitPushChangesExecute(r ToolboxAPIGitPushChangesRequ
 	return localVarHTTPResponse, nil
 }
 
+type ToolboxAPIGitResetRepositoryRequest struct {
+	ctx                    context.Context
+	ApiService             ToolboxAPI
+	workspaceId            string
+	gitResetRequest        *GitResetRequest
+	xDaytonaOrganizationID *string
+}
+
+func (r ToolboxAPIGitResetRepositoryRequest) GitResetRequest(gitResetRequest GitResetRequest) ToolboxAPIGitResetRepositoryRequest {
+	r.gitResetRequest = &gitResetRequest
+	return r
+}
+
+// Use with JWT to specify the organization ID
+func (r ToolboxAPIGitResetRepositoryRequest) XDaytonaOrganizationID(xDaytonaOrganizationID string) ToolboxAPIGitResetRepositoryRequest {
+	r.xDaytonaOrganizationID = &xDaytonaOrganizationID
+	return r
+}
+
+func (r ToolboxAPIGitResetRepositoryRequest) Execute() (*GitResetResponse, *http.Response, error) {
+	return r.ApiService.GitResetRepositoryExecute(r)
+}
+
+/*
+GitResetRepository Reset repository
+
+Fetch the remote and reset the current branch to its upstream branch
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId
+	@return ToolboxAPIGitResetRepositoryRequest
+*/
+func (a *ToolboxAPIService) GitResetRepository(ctx context.Context, workspaceId string) ToolboxAPIGitResetRepositoryRequest {
+	return ToolboxAPIGitResetRepositoryRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return GitResetResponse
+func (a *ToolboxAPIService) GitResetRepositoryExecute(r ToolboxAPIGitResetRepositoryRequest) (*GitResetResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *GitResetResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ToolboxAPIService.GitResetRepository")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/toolbox/{workspaceId}/toolbox/git/reset"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.gitResetRequest == nil {
+		return localVarReturnValue, nil, reportError("gitResetRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xDaytonaOrganizationID != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Daytona-Organization-ID", r.xDaytonaOrganizationID, "simple", "")
+	}
+	// body params
+	localVarPostBody = r.gitResetRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ToolboxAPIListFilesRequest struct {
 	ctx                    context.Context
 	ApiService             ToolboxAPI
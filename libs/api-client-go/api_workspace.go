@@ -122,6 +122,18 @@ type WorkspaceAPI interface {
 	//  @return []Workspace
 	ListWorkspacesExecute(r WorkspaceAPIListWorkspacesRequest) ([]Workspace, *http.Response, error)
 
+	/*
+		RebuildWorkspace Rebuild workspace
+
+		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+		@param workspaceId ID of the workspace
+		@return WorkspaceAPIRebuildWorkspaceRequest
+	*/
+	RebuildWorkspace(ctx context.Context, workspaceId string) WorkspaceAPIRebuildWorkspaceRequest
+
+	// RebuildWorkspaceExecute executes the request
+	RebuildWorkspaceExecute(r WorkspaceAPIRebuildWorkspaceRequest) (*http.Response, error)
+
 	/*
 		ReplaceLabels Replace workspace labels
 
@@ -1106,6 +1118,116 @@ func (a *WorkspaceAPIService) ListWorkspacesExecute(r WorkspaceAPIListWorkspaces
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type WorkspaceAPIRebuildWorkspaceRequest struct {
+	ctx                    context.Context
+	ApiService             WorkspaceAPI
+	workspaceId            string
+	xDaytonaOrganizationID *string
+	noCache                *bool
+}
+
+// Use with JWT to specify the organization ID
+func (r WorkspaceAPIRebuildWorkspaceRequest) XDaytonaOrganizationID(xDaytonaOrganizationID string) WorkspaceAPIRebuildWorkspaceRequest {
+	r.xDaytonaOrganizationID = &xDaytonaOrganizationID
+	return r
+}
+
+// Rebuild the image without the build cache
+func (r WorkspaceAPIRebuildWorkspaceRequest) NoCache(noCache bool) WorkspaceAPIRebuildWorkspaceRequest {
+	r.noCache = &noCache
+	return r
+}
+
+func (r WorkspaceAPIRebuildWorkspaceRequest) Execute() (*http.Response, error) {
+	return r.ApiService.RebuildWorkspaceExecute(r)
+}
+
+/*
+RebuildWorkspace Rebuild workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId ID of the workspace
+	@return WorkspaceAPIRebuildWorkspaceRequest
+*/
+func (a *WorkspaceAPIService) RebuildWorkspace(ctx context.Context, workspaceId string) WorkspaceAPIRebuildWorkspaceRequest {
+	return WorkspaceAPIRebuildWorkspaceRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+func (a *WorkspaceAPIService) RebuildWorkspaceExecute(r WorkspaceAPIRebuildWorkspaceRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceAPIService.RebuildWorkspace")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/rebuild"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.noCache != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "noCache", r.noCache, "form", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xDaytonaOrganizationID != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Daytona-Organization-ID", r.xDaytonaOrganizationID, "simple", "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type WorkspaceAPIReplaceLabelsRequest struct {
 	ctx                    context.Context
 	ApiService             WorkspaceAPI
@@ -1438,6 +1560,7 @@ type WorkspaceAPIStopWorkspaceRequest struct {
 	ApiService             WorkspaceAPI
 	workspaceId            string
 	xDaytonaOrganizationID *string
+	timeout                *float32
 }
 
 // Use with JWT to specify the organization ID
@@ -1446,6 +1569,12 @@ func (r WorkspaceAPIStopWorkspaceRequest) XDaytonaOrganizationID(xDaytonaOrganiz
 	return r
 }
 
+// Seconds the workspace gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the workspace. 0 kills it immediately
+func (r WorkspaceAPIStopWorkspaceRequest) Timeout(timeout float32) WorkspaceAPIStopWorkspaceRequest {
+	r.timeout = &timeout
+	return r
+}
+
 func (r WorkspaceAPIStopWorkspaceRequest) Execute() (*http.Response, error) {
 	return r.ApiService.StopWorkspaceExecute(r)
 }
@@ -1485,6 +1614,9 @@ func (a *WorkspaceAPIService) StopWorkspaceExecute(r WorkspaceAPIStopWorkspaceRe
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.timeout != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "timeout", r.timeout, "form", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -26,6 +26,8 @@ type CreateBuildInfo struct {
 	DockerfileContent string `json:"dockerfileContent"`
 	// The context hashes used for the build
 	ContextHashes []string `json:"contextHashes,omitempty"`
+	// Rebuild the image even if it was built before, without using the build cache
+	NoCache *bool `json:"noCache,omitempty"`
 }
 
 type _CreateBuildInfo CreateBuildInfo
@@ -104,6 +106,38 @@ func (o *CreateBuildInfo) SetContextHashes(v []string) {
 	o.ContextHashes = v
 }
 
+// GetNoCache returns the NoCache field value if set, zero value otherwise.
+func (o *CreateBuildInfo) GetNoCache() bool {
+	if o == nil || IsNil(o.NoCache) {
+		var ret bool
+		return ret
+	}
+	return *o.NoCache
+}
+
+// GetNoCacheOk returns a tuple with the NoCache field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateBuildInfo) GetNoCacheOk() (*bool, bool) {
+	if o == nil || IsNil(o.NoCache) {
+		return nil, false
+	}
+	return o.NoCache, true
+}
+
+// HasNoCache returns a boolean if a field has been set.
+func (o *CreateBuildInfo) HasNoCache() bool {
+	if o != nil && !IsNil(o.NoCache) {
+		return true
+	}
+
+	return false
+}
+
+// SetNoCache gets a reference to the given bool and assigns it to the NoCache field.
+func (o *CreateBuildInfo) SetNoCache(v bool) {
+	o.NoCache = &v
+}
+
 func (o CreateBuildInfo) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -118,6 +152,9 @@ func (o CreateBuildInfo) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ContextHashes) {
 		toSerialize["contextHashes"] = o.ContextHashes
 	}
+	if !IsNil(o.NoCache) {
+		toSerialize["noCache"] = o.NoCache
+	}
 	return toSerialize, nil
 }
 
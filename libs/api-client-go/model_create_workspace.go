@@ -48,6 +48,46 @@ type CreateWorkspace struct {
 	Volumes []WorkspaceVolume `json:"volumes,omitempty"`
 	// Build information for the workspace
 	BuildInfo *CreateBuildInfo `json:"buildInfo,omitempty"`
+	// Create the workspace without starting it, it stays stopped until it is started
+	NoStart *bool `json:"noStart,omitempty"`
+	// Entrypoint of the workspace container, overrides the entrypoint of the image
+	Entrypoint []string `json:"entrypoint,omitempty"`
+	// Command of the workspace container, overrides the command of the image. The Daytona daemon is started alongside it
+	Cmd []string `json:"cmd,omitempty"`
+	// Probe that must succeed before the workspace is reported as started. Either port or command must be set
+	ReadinessProbe *ReadinessProbe `json:"readinessProbe,omitempty"`
+	// Custom DNS servers of the workspace container
+	Dns []string `json:"dns,omitempty"`
+	// Additional host-to-IP mappings of the workspace container (format: HOST:IP)
+	ExtraHosts []string `json:"extraHosts,omitempty"`
+	// Mount the root filesystem of the workspace read-only. /tmp, /run and the home directory of the user stay writable
+	ReadonlyRootfs *bool `json:"readonlyRootfs,omitempty"`
+	// Additional tmpfs mounts of the workspace container (format: PATH[:OPTIONS], e.g. /var/cache:size=64m)
+	Tmpfs []string `json:"tmpfs,omitempty"`
+	// Security profiles and capabilities of the workspace container. Setting them makes the workspace unprivileged
+	Security *WorkspaceSecurity `json:"security,omitempty"`
+	// Run the workspace container privileged, needed for Docker-in-Docker. Defaults to true if the node allows privileged workspaces and no security settings are given
+	Privileged *bool `json:"privileged,omitempty"`
+	// Bind mount the Docker socket of the node into the workspace. This gives the workspace full control over the Docker daemon of the node
+	MountDockerSocket *bool `json:"mountDockerSocket,omitempty"`
+	// Resource limits of the workspace processes. nofile defaults to a soft limit of 65536 and a hard limit of 1048576
+	Ulimits []WorkspaceUlimit `json:"ulimits,omitempty"`
+	// Steps that run to completion in order before the workspace first starts, sharing the volumes of the workspace
+	InitSteps []WorkspaceInitStep `json:"initSteps,omitempty"`
+	// Files written into the workspace before it first starts. With a read-only root filesystem, paths must be on a volume
+	Files []WorkspaceFile `json:"files,omitempty"`
+	// Hostname of the workspace container, defaults to the workspace ID
+	Hostname *string `json:"hostname,omitempty"`
+	// Domain name of the workspace container
+	Domainname *string `json:"domainname,omitempty"`
+	// Image pull policy of the workspace and init step images. Defaults to always for images tagged latest and if-not-present otherwise
+	ImagePullPolicy *string `json:"imagePullPolicy,omitempty"`
+	// Shell commands run in order inside the workspace after it first starts and becomes ready, e.g. to install dependencies
+	PostCreateCommands []string `json:"postCreateCommands,omitempty"`
+	// Keep the workspace when a post-create command fails instead of failing the creation
+	PostCreateContinueOnError *bool `json:"postCreateContinueOnError,omitempty"`
+	// Seconds the workspace gets to shut down after SIGTERM when it is stopped before it is killed, e.g. to let a database flush. Killed immediately by default
+	StopGracePeriodSeconds *int32 `json:"stopGracePeriodSeconds,omitempty"`
 }
 
 // NewCreateWorkspace instantiates a new CreateWorkspace object
@@ -515,6 +555,646 @@ func (o *CreateWorkspace) SetBuildInfo(v CreateBuildInfo) {
 	o.BuildInfo = &v
 }
 
+// GetNoStart returns the NoStart field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetNoStart() bool {
+	if o == nil || IsNil(o.NoStart) {
+		var ret bool
+		return ret
+	}
+	return *o.NoStart
+}
+
+// GetNoStartOk returns a tuple with the NoStart field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetNoStartOk() (*bool, bool) {
+	if o == nil || IsNil(o.NoStart) {
+		return nil, false
+	}
+	return o.NoStart, true
+}
+
+// HasNoStart returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasNoStart() bool {
+	if o != nil && !IsNil(o.NoStart) {
+		return true
+	}
+
+	return false
+}
+
+// SetNoStart gets a reference to the given bool and assigns it to the NoStart field.
+func (o *CreateWorkspace) SetNoStart(v bool) {
+	o.NoStart = &v
+}
+
+// GetEntrypoint returns the Entrypoint field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetEntrypoint() []string {
+	if o == nil || IsNil(o.Entrypoint) {
+		var ret []string
+		return ret
+	}
+	return o.Entrypoint
+}
+
+// GetEntrypointOk returns a tuple with the Entrypoint field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetEntrypointOk() ([]string, bool) {
+	if o == nil || IsNil(o.Entrypoint) {
+		return []string{}, false
+	}
+	return o.Entrypoint, true
+}
+
+// HasEntrypoint returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasEntrypoint() bool {
+	if o != nil && !IsNil(o.Entrypoint) {
+		return true
+	}
+
+	return false
+}
+
+// SetEntrypoint gets a reference to the given []string and assigns it to the Entrypoint field.
+func (o *CreateWorkspace) SetEntrypoint(v []string) {
+	o.Entrypoint = v
+}
+
+// GetCmd returns the Cmd field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetCmd() []string {
+	if o == nil || IsNil(o.Cmd) {
+		var ret []string
+		return ret
+	}
+	return o.Cmd
+}
+
+// GetCmdOk returns a tuple with the Cmd field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetCmdOk() ([]string, bool) {
+	if o == nil || IsNil(o.Cmd) {
+		return []string{}, false
+	}
+	return o.Cmd, true
+}
+
+// HasCmd returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasCmd() bool {
+	if o != nil && !IsNil(o.Cmd) {
+		return true
+	}
+
+	return false
+}
+
+// SetCmd gets a reference to the given []string and assigns it to the Cmd field.
+func (o *CreateWorkspace) SetCmd(v []string) {
+	o.Cmd = v
+}
+
+// GetReadinessProbe returns the ReadinessProbe field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetReadinessProbe() ReadinessProbe {
+	if o == nil || IsNil(o.ReadinessProbe) {
+		var ret ReadinessProbe
+		return ret
+	}
+	return *o.ReadinessProbe
+}
+
+// GetReadinessProbeOk returns a tuple with the ReadinessProbe field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetReadinessProbeOk() (*ReadinessProbe, bool) {
+	if o == nil || IsNil(o.ReadinessProbe) {
+		return nil, false
+	}
+	return o.ReadinessProbe, true
+}
+
+// HasReadinessProbe returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasReadinessProbe() bool {
+	if o != nil && !IsNil(o.ReadinessProbe) {
+		return true
+	}
+
+	return false
+}
+
+// SetReadinessProbe gets a reference to the given ReadinessProbe and assigns it to the ReadinessProbe field.
+func (o *CreateWorkspace) SetReadinessProbe(v ReadinessProbe) {
+	o.ReadinessProbe = &v
+}
+
+// GetDns returns the Dns field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetDns() []string {
+	if o == nil || IsNil(o.Dns) {
+		var ret []string
+		return ret
+	}
+	return o.Dns
+}
+
+// GetDnsOk returns a tuple with the Dns field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetDnsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Dns) {
+		return []string{}, false
+	}
+	return o.Dns, true
+}
+
+// HasDns returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasDns() bool {
+	if o != nil && !IsNil(o.Dns) {
+		return true
+	}
+
+	return false
+}
+
+// SetDns gets a reference to the given []string and assigns it to the Dns field.
+func (o *CreateWorkspace) SetDns(v []string) {
+	o.Dns = v
+}
+
+// GetExtraHosts returns the ExtraHosts field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetExtraHosts() []string {
+	if o == nil || IsNil(o.ExtraHosts) {
+		var ret []string
+		return ret
+	}
+	return o.ExtraHosts
+}
+
+// GetExtraHostsOk returns a tuple with the ExtraHosts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetExtraHostsOk() ([]string, bool) {
+	if o == nil || IsNil(o.ExtraHosts) {
+		return []string{}, false
+	}
+	return o.ExtraHosts, true
+}
+
+// HasExtraHosts returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasExtraHosts() bool {
+	if o != nil && !IsNil(o.ExtraHosts) {
+		return true
+	}
+
+	return false
+}
+
+// SetExtraHosts gets a reference to the given []string and assigns it to the ExtraHosts field.
+func (o *CreateWorkspace) SetExtraHosts(v []string) {
+	o.ExtraHosts = v
+}
+
+// GetReadonlyRootfs returns the ReadonlyRootfs field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetReadonlyRootfs() bool {
+	if o == nil || IsNil(o.ReadonlyRootfs) {
+		var ret bool
+		return ret
+	}
+	return *o.ReadonlyRootfs
+}
+
+// GetReadonlyRootfsOk returns a tuple with the ReadonlyRootfs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetReadonlyRootfsOk() (*bool, bool) {
+	if o == nil || IsNil(o.ReadonlyRootfs) {
+		return nil, false
+	}
+	return o.ReadonlyRootfs, true
+}
+
+// HasReadonlyRootfs returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasReadonlyRootfs() bool {
+	if o != nil && !IsNil(o.ReadonlyRootfs) {
+		return true
+	}
+
+	return false
+}
+
+// SetReadonlyRootfs gets a reference to the given bool and assigns it to the ReadonlyRootfs field.
+func (o *CreateWorkspace) SetReadonlyRootfs(v bool) {
+	o.ReadonlyRootfs = &v
+}
+
+// GetTmpfs returns the Tmpfs field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetTmpfs() []string {
+	if o == nil || IsNil(o.Tmpfs) {
+		var ret []string
+		return ret
+	}
+	return o.Tmpfs
+}
+
+// GetTmpfsOk returns a tuple with the Tmpfs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetTmpfsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Tmpfs) {
+		return []string{}, false
+	}
+	return o.Tmpfs, true
+}
+
+// HasTmpfs returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasTmpfs() bool {
+	if o != nil && !IsNil(o.Tmpfs) {
+		return true
+	}
+
+	return false
+}
+
+// SetTmpfs gets a reference to the given []string and assigns it to the Tmpfs field.
+func (o *CreateWorkspace) SetTmpfs(v []string) {
+	o.Tmpfs = v
+}
+
+// GetSecurity returns the Security field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetSecurity() WorkspaceSecurity {
+	if o == nil || IsNil(o.Security) {
+		var ret WorkspaceSecurity
+		return ret
+	}
+	return *o.Security
+}
+
+// GetSecurityOk returns a tuple with the Security field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetSecurityOk() (*WorkspaceSecurity, bool) {
+	if o == nil || IsNil(o.Security) {
+		return nil, false
+	}
+	return o.Security, true
+}
+
+// HasSecurity returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasSecurity() bool {
+	if o != nil && !IsNil(o.Security) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurity gets a reference to the given WorkspaceSecurity and assigns it to the Security field.
+func (o *CreateWorkspace) SetSecurity(v WorkspaceSecurity) {
+	o.Security = &v
+}
+
+// GetPrivileged returns the Privileged field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetPrivileged() bool {
+	if o == nil || IsNil(o.Privileged) {
+		var ret bool
+		return ret
+	}
+	return *o.Privileged
+}
+
+// GetPrivilegedOk returns a tuple with the Privileged field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetPrivilegedOk() (*bool, bool) {
+	if o == nil || IsNil(o.Privileged) {
+		return nil, false
+	}
+	return o.Privileged, true
+}
+
+// HasPrivileged returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasPrivileged() bool {
+	if o != nil && !IsNil(o.Privileged) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrivileged gets a reference to the given bool and assigns it to the Privileged field.
+func (o *CreateWorkspace) SetPrivileged(v bool) {
+	o.Privileged = &v
+}
+
+// GetMountDockerSocket returns the MountDockerSocket field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetMountDockerSocket() bool {
+	if o == nil || IsNil(o.MountDockerSocket) {
+		var ret bool
+		return ret
+	}
+	return *o.MountDockerSocket
+}
+
+// GetMountDockerSocketOk returns a tuple with the MountDockerSocket field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetMountDockerSocketOk() (*bool, bool) {
+	if o == nil || IsNil(o.MountDockerSocket) {
+		return nil, false
+	}
+	return o.MountDockerSocket, true
+}
+
+// HasMountDockerSocket returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasMountDockerSocket() bool {
+	if o != nil && !IsNil(o.MountDockerSocket) {
+		return true
+	}
+
+	return false
+}
+
+// SetMountDockerSocket gets a reference to the given bool and assigns it to the MountDockerSocket field.
+func (o *CreateWorkspace) SetMountDockerSocket(v bool) {
+	o.MountDockerSocket = &v
+}
+
+// GetUlimits returns the Ulimits field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetUlimits() []WorkspaceUlimit {
+	if o == nil || IsNil(o.Ulimits) {
+		var ret []WorkspaceUlimit
+		return ret
+	}
+	return o.Ulimits
+}
+
+// GetUlimitsOk returns a tuple with the Ulimits field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetUlimitsOk() ([]WorkspaceUlimit, bool) {
+	if o == nil || IsNil(o.Ulimits) {
+		return []WorkspaceUlimit{}, false
+	}
+	return o.Ulimits, true
+}
+
+// HasUlimits returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasUlimits() bool {
+	if o != nil && !IsNil(o.Ulimits) {
+		return true
+	}
+
+	return false
+}
+
+// SetUlimits gets a reference to the given []WorkspaceUlimit and assigns it to the Ulimits field.
+func (o *CreateWorkspace) SetUlimits(v []WorkspaceUlimit) {
+	o.Ulimits = v
+}
+
+// GetInitSteps returns the InitSteps field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetInitSteps() []WorkspaceInitStep {
+	if o == nil || IsNil(o.InitSteps) {
+		var ret []WorkspaceInitStep
+		return ret
+	}
+	return o.InitSteps
+}
+
+// GetInitStepsOk returns a tuple with the InitSteps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetInitStepsOk() ([]WorkspaceInitStep, bool) {
+	if o == nil || IsNil(o.InitSteps) {
+		return []WorkspaceInitStep{}, false
+	}
+	return o.InitSteps, true
+}
+
+// HasInitSteps returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasInitSteps() bool {
+	if o != nil && !IsNil(o.InitSteps) {
+		return true
+	}
+
+	return false
+}
+
+// SetInitSteps gets a reference to the given []WorkspaceInitStep and assigns it to the InitSteps field.
+func (o *CreateWorkspace) SetInitSteps(v []WorkspaceInitStep) {
+	o.InitSteps = v
+}
+
+// GetFiles returns the Files field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetFiles() []WorkspaceFile {
+	if o == nil || IsNil(o.Files) {
+		var ret []WorkspaceFile
+		return ret
+	}
+	return o.Files
+}
+
+// GetFilesOk returns a tuple with the Files field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetFilesOk() ([]WorkspaceFile, bool) {
+	if o == nil || IsNil(o.Files) {
+		return []WorkspaceFile{}, false
+	}
+	return o.Files, true
+}
+
+// HasFiles returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasFiles() bool {
+	if o != nil && !IsNil(o.Files) {
+		return true
+	}
+
+	return false
+}
+
+// SetFiles gets a reference to the given []WorkspaceFile and assigns it to the Files field.
+func (o *CreateWorkspace) SetFiles(v []WorkspaceFile) {
+	o.Files = v
+}
+
+// GetHostname returns the Hostname field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetHostname() string {
+	if o == nil || IsNil(o.Hostname) {
+		var ret string
+		return ret
+	}
+	return *o.Hostname
+}
+
+// GetHostnameOk returns a tuple with the Hostname field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetHostnameOk() (*string, bool) {
+	if o == nil || IsNil(o.Hostname) {
+		return nil, false
+	}
+	return o.Hostname, true
+}
+
+// HasHostname returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasHostname() bool {
+	if o != nil && !IsNil(o.Hostname) {
+		return true
+	}
+
+	return false
+}
+
+// SetHostname gets a reference to the given string and assigns it to the Hostname field.
+func (o *CreateWorkspace) SetHostname(v string) {
+	o.Hostname = &v
+}
+
+// GetDomainname returns the Domainname field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetDomainname() string {
+	if o == nil || IsNil(o.Domainname) {
+		var ret string
+		return ret
+	}
+	return *o.Domainname
+}
+
+// GetDomainnameOk returns a tuple with the Domainname field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetDomainnameOk() (*string, bool) {
+	if o == nil || IsNil(o.Domainname) {
+		return nil, false
+	}
+	return o.Domainname, true
+}
+
+// HasDomainname returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasDomainname() bool {
+	if o != nil && !IsNil(o.Domainname) {
+		return true
+	}
+
+	return false
+}
+
+// SetDomainname gets a reference to the given string and assigns it to the Domainname field.
+func (o *CreateWorkspace) SetDomainname(v string) {
+	o.Domainname = &v
+}
+
+// GetImagePullPolicy returns the ImagePullPolicy field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetImagePullPolicy() string {
+	if o == nil || IsNil(o.ImagePullPolicy) {
+		var ret string
+		return ret
+	}
+	return *o.ImagePullPolicy
+}
+
+// GetImagePullPolicyOk returns a tuple with the ImagePullPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetImagePullPolicyOk() (*string, bool) {
+	if o == nil || IsNil(o.ImagePullPolicy) {
+		return nil, false
+	}
+	return o.ImagePullPolicy, true
+}
+
+// HasImagePullPolicy returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasImagePullPolicy() bool {
+	if o != nil && !IsNil(o.ImagePullPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetImagePullPolicy gets a reference to the given string and assigns it to the ImagePullPolicy field.
+func (o *CreateWorkspace) SetImagePullPolicy(v string) {
+	o.ImagePullPolicy = &v
+}
+
+// GetPostCreateCommands returns the PostCreateCommands field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetPostCreateCommands() []string {
+	if o == nil || IsNil(o.PostCreateCommands) {
+		var ret []string
+		return ret
+	}
+	return o.PostCreateCommands
+}
+
+// GetPostCreateCommandsOk returns a tuple with the PostCreateCommands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetPostCreateCommandsOk() ([]string, bool) {
+	if o == nil || IsNil(o.PostCreateCommands) {
+		return []string{}, false
+	}
+	return o.PostCreateCommands, true
+}
+
+// HasPostCreateCommands returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasPostCreateCommands() bool {
+	if o != nil && !IsNil(o.PostCreateCommands) {
+		return true
+	}
+
+	return false
+}
+
+// SetPostCreateCommands gets a reference to the given []string and assigns it to the PostCreateCommands field.
+func (o *CreateWorkspace) SetPostCreateCommands(v []string) {
+	o.PostCreateCommands = v
+}
+
+// GetPostCreateContinueOnError returns the PostCreateContinueOnError field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetPostCreateContinueOnError() bool {
+	if o == nil || IsNil(o.PostCreateContinueOnError) {
+		var ret bool
+		return ret
+	}
+	return *o.PostCreateContinueOnError
+}
+
+// GetPostCreateContinueOnErrorOk returns a tuple with the PostCreateContinueOnError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetPostCreateContinueOnErrorOk() (*bool, bool) {
+	if o == nil || IsNil(o.PostCreateContinueOnError) {
+		return nil, false
+	}
+	return o.PostCreateContinueOnError, true
+}
+
+// HasPostCreateContinueOnError returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasPostCreateContinueOnError() bool {
+	if o != nil && !IsNil(o.PostCreateContinueOnError) {
+		return true
+	}
+
+	return false
+}
+
+// SetPostCreateContinueOnError gets a reference to the given bool and assigns it to the PostCreateContinueOnError field.
+func (o *CreateWorkspace) SetPostCreateContinueOnError(v bool) {
+	o.PostCreateContinueOnError = &v
+}
+
+// GetStopGracePeriodSeconds returns the StopGracePeriodSeconds field value if set, zero value otherwise.
+func (o *CreateWorkspace) GetStopGracePeriodSeconds() int32 {
+	if o == nil || IsNil(o.StopGracePeriodSeconds) {
+		var ret int32
+		return ret
+	}
+	return *o.StopGracePeriodSeconds
+}
+
+// GetStopGracePeriodSecondsOk returns a tuple with the StopGracePeriodSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspace) GetStopGracePeriodSecondsOk() (*int32, bool) {
+	if o == nil || IsNil(o.StopGracePeriodSeconds) {
+		return nil, false
+	}
+	return o.StopGracePeriodSeconds, true
+}
+
+// HasStopGracePeriodSeconds returns a boolean if a field has been set.
+func (o *CreateWorkspace) HasStopGracePeriodSeconds() bool {
+	if o != nil && !IsNil(o.StopGracePeriodSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetStopGracePeriodSeconds gets a reference to the given int32 and assigns it to the StopGracePeriodSeconds field.
+func (o *CreateWorkspace) SetStopGracePeriodSeconds(v int32) {
+	o.StopGracePeriodSeconds = &v
+}
+
 func (o CreateWorkspace) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -567,6 +1247,66 @@ func (o CreateWorkspace) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildInfo) {
 		toSerialize["buildInfo"] = o.BuildInfo
 	}
+	if !IsNil(o.NoStart) {
+		toSerialize["noStart"] = o.NoStart
+	}
+	if !IsNil(o.Entrypoint) {
+		toSerialize["entrypoint"] = o.Entrypoint
+	}
+	if !IsNil(o.Cmd) {
+		toSerialize["cmd"] = o.Cmd
+	}
+	if !IsNil(o.ReadinessProbe) {
+		toSerialize["readinessProbe"] = o.ReadinessProbe
+	}
+	if !IsNil(o.Dns) {
+		toSerialize["dns"] = o.Dns
+	}
+	if !IsNil(o.ExtraHosts) {
+		toSerialize["extraHosts"] = o.ExtraHosts
+	}
+	if !IsNil(o.ReadonlyRootfs) {
+		toSerialize["readonlyRootfs"] = o.ReadonlyRootfs
+	}
+	if !IsNil(o.Tmpfs) {
+		toSerialize["tmpfs"] = o.Tmpfs
+	}
+	if !IsNil(o.Security) {
+		toSerialize["security"] = o.Security
+	}
+	if !IsNil(o.Privileged) {
+		toSerialize["privileged"] = o.Privileged
+	}
+	if !IsNil(o.MountDockerSocket) {
+		toSerialize["mountDockerSocket"] = o.MountDockerSocket
+	}
+	if !IsNil(o.Ulimits) {
+		toSerialize["ulimits"] = o.Ulimits
+	}
+	if !IsNil(o.InitSteps) {
+		toSerialize["initSteps"] = o.InitSteps
+	}
+	if !IsNil(o.Files) {
+		toSerialize["files"] = o.Files
+	}
+	if !IsNil(o.Hostname) {
+		toSerialize["hostname"] = o.Hostname
+	}
+	if !IsNil(o.Domainname) {
+		toSerialize["domainname"] = o.Domainname
+	}
+	if !IsNil(o.ImagePullPolicy) {
+		toSerialize["imagePullPolicy"] = o.ImagePullPolicy
+	}
+	if !IsNil(o.PostCreateCommands) {
+		toSerialize["postCreateCommands"] = o.PostCreateCommands
+	}
+	if !IsNil(o.PostCreateContinueOnError) {
+		toSerialize["postCreateContinueOnError"] = o.PostCreateContinueOnError
+	}
+	if !IsNil(o.StopGracePeriodSeconds) {
+		toSerialize["stopGracePeriodSeconds"] = o.StopGracePeriodSeconds
+	}
 	return toSerialize, nil
 }
 
@@ -29,6 +29,12 @@ type WorkspaceInfo struct {
 	Name string `json:"name"`
 	// Additional metadata provided by the provider
 	ProviderMetadata *string `json:"providerMetadata,omitempty"`
+	// Registry digest of the image the workspace is running, only included in verbose output
+	ImageDigest *string `json:"imageDigest,omitempty"`
+	// Number of times the workspace was restarted by its restart policy, only included in verbose output
+	RestartCount *float32 `json:"restartCount,omitempty"`
+	// Durations in seconds of the steps of the last workspace creation, only included in verbose output
+	PhaseDurations *map[string]float32 `json:"phaseDurations,omitempty"`
 }
 
 type _WorkspaceInfo WorkspaceInfo
@@ -137,6 +143,102 @@ func (o *WorkspaceInfo) SetProviderMetadata(v string) {
 	o.ProviderMetadata = &v
 }
 
+// GetImageDigest returns the ImageDigest field value if set, zero value otherwise.
+func (o *WorkspaceInfo) GetImageDigest() string {
+	if o == nil || IsNil(o.ImageDigest) {
+		var ret string
+		return ret
+	}
+	return *o.ImageDigest
+}
+
+// GetImageDigestOk returns a tuple with the ImageDigest field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceInfo) GetImageDigestOk() (*string, bool) {
+	if o == nil || IsNil(o.ImageDigest) {
+		return nil, false
+	}
+	return o.ImageDigest, true
+}
+
+// HasImageDigest returns a boolean if a field has been set.
+func (o *WorkspaceInfo) HasImageDigest() bool {
+	if o != nil && !IsNil(o.ImageDigest) {
+		return true
+	}
+
+	return false
+}
+
+// SetImageDigest gets a reference to the given string and assigns it to the ImageDigest field.
+func (o *WorkspaceInfo) SetImageDigest(v string) {
+	o.ImageDigest = &v
+}
+
+// GetRestartCount returns the RestartCount field value if set, zero value otherwise.
+func (o *WorkspaceInfo) GetRestartCount() float32 {
+	if o == nil || IsNil(o.RestartCount) {
+		var ret float32
+		return ret
+	}
+	return *o.RestartCount
+}
+
+// GetRestartCountOk returns a tuple with the RestartCount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceInfo) GetRestartCountOk() (*float32, bool) {
+	if o == nil || IsNil(o.RestartCount) {
+		return nil, false
+	}
+	return o.RestartCount, true
+}
+
+// HasRestartCount returns a boolean if a field has been set.
+func (o *WorkspaceInfo) HasRestartCount() bool {
+	if o != nil && !IsNil(o.RestartCount) {
+		return true
+	}
+
+	return false
+}
+
+// SetRestartCount gets a reference to the given float32 and assigns it to the RestartCount field.
+func (o *WorkspaceInfo) SetRestartCount(v float32) {
+	o.RestartCount = &v
+}
+
+// GetPhaseDurations returns the PhaseDurations field value if set, zero value otherwise.
+func (o *WorkspaceInfo) GetPhaseDurations() map[string]float32 {
+	if o == nil || IsNil(o.PhaseDurations) {
+		var ret map[string]float32
+		return ret
+	}
+	return *o.PhaseDurations
+}
+
+// GetPhaseDurationsOk returns a tuple with the PhaseDurations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceInfo) GetPhaseDurationsOk() (*map[string]float32, bool) {
+	if o == nil || IsNil(o.PhaseDurations) {
+		return nil, false
+	}
+	return o.PhaseDurations, true
+}
+
+// HasPhaseDurations returns a boolean if a field has been set.
+func (o *WorkspaceInfo) HasPhaseDurations() bool {
+	if o != nil && !IsNil(o.PhaseDurations) {
+		return true
+	}
+
+	return false
+}
+
+// SetPhaseDurations gets a reference to the given map[string]float32 and assigns it to the PhaseDurations field.
+func (o *WorkspaceInfo) SetPhaseDurations(v map[string]float32) {
+	o.PhaseDurations = &v
+}
+
 func (o WorkspaceInfo) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -152,6 +254,15 @@ func (o WorkspaceInfo) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ProviderMetadata) {
 		toSerialize["providerMetadata"] = o.ProviderMetadata
 	}
+	if !IsNil(o.ImageDigest) {
+		toSerialize["imageDigest"] = o.ImageDigest
+	}
+	if !IsNil(o.RestartCount) {
+		toSerialize["restartCount"] = o.RestartCount
+	}
+	if !IsNil(o.PhaseDurations) {
+		toSerialize["phaseDurations"] = o.PhaseDurations
+	}
 	return toSerialize, nil
 }
 
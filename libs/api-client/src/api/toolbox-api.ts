@@ -62,6 +62,10 @@ import type { GitDeleteBranchRequest } from '../models'
 // @ts-ignore
 import type { GitRepoRequest } from '../models'
 // @ts-ignore
+import type { GitResetRequest } from '../models'
+// @ts-ignore
+import type { GitResetResponse } from '../models'
+// @ts-ignore
 import type { GitStatus } from '../models'
 // @ts-ignore
 import type { ListBranchResponse } from '../models'
@@ -1435,6 +1439,61 @@ export const ToolboxApiAxiosParamCreator = function (configuration?: Configurati
         options: localVarRequestOptions,
       }
     },
+    /**
+     * Fetch the remote and reset the current branch to its upstream branch
+     * @summary Reset repository
+     * @param {string} workspaceId
+     * @param {GitResetRequest} gitResetRequest
+     * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    gitResetRepository: async (
+      workspaceId: string,
+      gitResetRequest: GitResetRequest,
+      xDaytonaOrganizationID?: string,
+      options: RawAxiosRequestConfig = {},
+    ): Promise<RequestArgs> => {
+      // verify required parameter 'workspaceId' is not null or undefined
+      assertParamExists('gitResetRepository', 'workspaceId', workspaceId)
+      // verify required parameter 'gitResetRequest' is not null or undefined
+      assertParamExists('gitResetRepository', 'gitResetRequest', gitResetRequest)
+      const localVarPath = `/toolbox/{workspaceId}/toolbox/git/reset`.replace(
+        `{${'workspaceId'}}`,
+        encodeURIComponent(String(workspaceId)),
+      )
+      // use dummy base URL string because the URL constructor only accepts absolute URLs.
+      const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL)
+      let baseOptions
+      if (configuration) {
+        baseOptions = configuration.baseOptions
+      }
+
+      const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options }
+      const localVarHeaderParameter = {} as any
+      const localVarQueryParameter = {} as any
+
+      // authentication bearer required
+      // http bearer authentication required
+      await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+      // authentication oauth2 required
+
+      localVarHeaderParameter['Content-Type'] = 'application/json'
+
+      if (xDaytonaOrganizationID != null) {
+        localVarHeaderParameter['X-Daytona-Organization-ID'] = String(xDaytonaOrganizationID)
+      }
+      setSearchParams(localVarUrlObj, localVarQueryParameter)
+      let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {}
+      localVarRequestOptions.headers = { ...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers }
+      localVarRequestOptions.data = serializeDataIfNeeded(gitResetRequest, localVarRequestOptions, configuration)
+
+      return {
+        url: toPathString(localVarUrlObj),
+        options: localVarRequestOptions,
+      }
+    },
     /**
      *
      * @summary List files
@@ -3121,6 +3180,38 @@ export const ToolboxApiFp = function (configuration?: Configuration) {
           configuration,
         )(axios, localVarOperationServerBasePath || basePath)
     },
+    /**
+     * Fetch the remote and reset the current branch to its upstream branch
+     * @summary Reset repository
+     * @param {string} workspaceId
+     * @param {GitResetRequest} gitResetRequest
+     * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    async gitResetRepository(
+      workspaceId: string,
+      gitResetRequest: GitResetRequest,
+      xDaytonaOrganizationID?: string,
+      options?: RawAxiosRequestConfig,
+    ): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<GitResetResponse>> {
+      const localVarAxiosArgs = await localVarAxiosParamCreator.gitResetRepository(
+        workspaceId,
+        gitResetRequest,
+        xDaytonaOrganizationID,
+        options,
+      )
+      const localVarOperationServerIndex = configuration?.serverIndex ?? 0
+      const localVarOperationServerBasePath =
+        operationServerMap['ToolboxApi.gitResetRepository']?.[localVarOperationServerIndex]?.url
+      return (axios, basePath) =>
+        createRequestFunction(
+          localVarAxiosArgs,
+          globalAxios,
+          BASE_PATH,
+          configuration,
+        )(axios, localVarOperationServerBasePath || basePath)
+    },
     /**
      *
      * @summary List files
@@ -4102,6 +4193,25 @@ export const ToolboxApiFactory = function (configuration?: Configuration, basePa
         .gitPushChanges(workspaceId, gitRepoRequest, xDaytonaOrganizationID, options)
         .then((request) => request(axios, basePath))
     },
+    /**
+     * Fetch the remote and reset the current branch to its upstream branch
+     * @summary Reset repository
+     * @param {string} workspaceId
+     * @param {GitResetRequest} gitResetRequest
+     * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    gitResetRepository(
+      workspaceId: string,
+      gitResetRequest: GitResetRequest,
+      xDaytonaOrganizationID?: string,
+      options?: RawAxiosRequestConfig,
+    ): AxiosPromise<GitResetResponse> {
+      return localVarFp
+        .gitResetRepository(workspaceId, gitResetRequest, xDaytonaOrganizationID, options)
+        .then((request) => request(axios, basePath))
+    },
     /**
      *
      * @summary List files
@@ -4924,6 +5034,27 @@ export class ToolboxApi extends BaseAPI {
       .then((request) => request(this.axios, this.basePath))
   }
 
+  /**
+   * Fetch the remote and reset the current branch to its upstream branch
+   * @summary Reset repository
+   * @param {string} workspaceId
+   * @param {GitResetRequest} gitResetRequest
+   * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+   * @param {*} [options] Override http request option.
+   * @throws {RequiredError}
+   * @memberof ToolboxApi
+   */
+  public gitResetRepository(
+    workspaceId: string,
+    gitResetRequest: GitResetRequest,
+    xDaytonaOrganizationID?: string,
+    options?: RawAxiosRequestConfig,
+  ) {
+    return ToolboxApiFp(this.configuration)
+      .gitResetRepository(workspaceId, gitResetRequest, xDaytonaOrganizationID, options)
+      .then((request) => request(this.axios, this.basePath))
+  }
+
   /**
    *
    * @summary List files
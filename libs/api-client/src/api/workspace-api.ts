@@ -457,6 +457,60 @@ export const WorkspaceApiAxiosParamCreator = function (configuration?: Configura
         options: localVarRequestOptions,
       }
     },
+    /**
+     *
+     * @summary Rebuild workspace
+     * @param {string} workspaceId ID of the workspace
+     * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {boolean} [noCache] Rebuild the image without the build cache
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    rebuildWorkspace: async (
+      workspaceId: string,
+      xDaytonaOrganizationID?: string,
+      noCache?: boolean,
+      options: RawAxiosRequestConfig = {},
+    ): Promise<RequestArgs> => {
+      // verify required parameter 'workspaceId' is not null or undefined
+      assertParamExists('rebuildWorkspace', 'workspaceId', workspaceId)
+      const localVarPath = `/workspace/{workspaceId}/rebuild`.replace(
+        `{${'workspaceId'}}`,
+        encodeURIComponent(String(workspaceId)),
+      )
+      // use dummy base URL string because the URL constructor only accepts absolute URLs.
+      const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL)
+      let baseOptions
+      if (configuration) {
+        baseOptions = configuration.baseOptions
+      }
+
+      const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options }
+      const localVarHeaderParameter = {} as any
+      const localVarQueryParameter = {} as any
+
+      // authentication bearer required
+      // http bearer authentication required
+      await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+      // authentication oauth2 required
+
+      if (noCache !== undefined) {
+        localVarQueryParameter['noCache'] = noCache
+      }
+
+      if (xDaytonaOrganizationID != null) {
+        localVarHeaderParameter['X-Daytona-Organization-ID'] = String(xDaytonaOrganizationID)
+      }
+      setSearchParams(localVarUrlObj, localVarQueryParameter)
+      let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {}
+      localVarRequestOptions.headers = { ...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers }
+
+      return {
+        url: toPathString(localVarUrlObj),
+        options: localVarRequestOptions,
+      }
+    },
     /**
      *
      * @summary Replace workspace labels
@@ -616,12 +670,14 @@ export const WorkspaceApiAxiosParamCreator = function (configuration?: Configura
      * @summary Stop workspace
      * @param {string} workspaceId ID of the workspace
      * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {number} [timeout] Seconds the workspace gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the workspace. 0 kills it immediately
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
     stopWorkspace: async (
       workspaceId: string,
       xDaytonaOrganizationID?: string,
+      timeout?: number,
       options: RawAxiosRequestConfig = {},
     ): Promise<RequestArgs> => {
       // verify required parameter 'workspaceId' is not null or undefined
@@ -647,6 +703,10 @@ export const WorkspaceApiAxiosParamCreator = function (configuration?: Configura
 
       // authentication oauth2 required
 
+      if (timeout !== undefined) {
+        localVarQueryParameter['timeout'] = timeout
+      }
+
       if (xDaytonaOrganizationID != null) {
         localVarHeaderParameter['X-Daytona-Organization-ID'] = String(xDaytonaOrganizationID)
       }
@@ -967,6 +1027,38 @@ export const WorkspaceApiFp = function (configuration?: Configuration) {
           configuration,
         )(axios, localVarOperationServerBasePath || basePath)
     },
+    /**
+     *
+     * @summary Rebuild workspace
+     * @param {string} workspaceId ID of the workspace
+     * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {boolean} [noCache] Rebuild the image without the build cache
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    async rebuildWorkspace(
+      workspaceId: string,
+      xDaytonaOrganizationID?: string,
+      noCache?: boolean,
+      options?: RawAxiosRequestConfig,
+    ): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+      const localVarAxiosArgs = await localVarAxiosParamCreator.rebuildWorkspace(
+        workspaceId,
+        xDaytonaOrganizationID,
+        noCache,
+        options,
+      )
+      const localVarOperationServerIndex = configuration?.serverIndex ?? 0
+      const localVarOperationServerBasePath =
+        operationServerMap['WorkspaceApi.rebuildWorkspace']?.[localVarOperationServerIndex]?.url
+      return (axios, basePath) =>
+        createRequestFunction(
+          localVarAxiosArgs,
+          globalAxios,
+          BASE_PATH,
+          configuration,
+        )(axios, localVarOperationServerBasePath || basePath)
+    },
     /**
      *
      * @summary Replace workspace labels
@@ -1065,17 +1157,20 @@ export const WorkspaceApiFp = function (configuration?: Configuration) {
      * @summary Stop workspace
      * @param {string} workspaceId ID of the workspace
      * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {number} [timeout] Seconds the workspace gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the workspace. 0 kills it immediately
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
     async stopWorkspace(
       workspaceId: string,
       xDaytonaOrganizationID?: string,
+      timeout?: number,
       options?: RawAxiosRequestConfig,
     ): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
       const localVarAxiosArgs = await localVarAxiosParamCreator.stopWorkspace(
         workspaceId,
         xDaytonaOrganizationID,
+        timeout,
         options,
       )
       const localVarOperationServerIndex = configuration?.serverIndex ?? 0
@@ -1277,6 +1372,25 @@ export const WorkspaceApiFactory = function (configuration?: Configuration, base
         .listWorkspaces(xDaytonaOrganizationID, verbose, labels, options)
         .then((request) => request(axios, basePath))
     },
+    /**
+     *
+     * @summary Rebuild workspace
+     * @param {string} workspaceId ID of the workspace
+     * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {boolean} [noCache] Rebuild the image without the build cache
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    rebuildWorkspace(
+      workspaceId: string,
+      xDaytonaOrganizationID?: string,
+      noCache?: boolean,
+      options?: RawAxiosRequestConfig,
+    ): AxiosPromise<void> {
+      return localVarFp
+        .rebuildWorkspace(workspaceId, xDaytonaOrganizationID, noCache, options)
+        .then((request) => request(axios, basePath))
+    },
     /**
      *
      * @summary Replace workspace labels
@@ -1337,16 +1451,18 @@ export const WorkspaceApiFactory = function (configuration?: Configuration, base
      * @summary Stop workspace
      * @param {string} workspaceId ID of the workspace
      * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+     * @param {number} [timeout] Seconds the workspace gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the workspace. 0 kills it immediately
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
     stopWorkspace(
       workspaceId: string,
       xDaytonaOrganizationID?: string,
+      timeout?: number,
       options?: RawAxiosRequestConfig,
     ): AxiosPromise<void> {
       return localVarFp
-        .stopWorkspace(workspaceId, xDaytonaOrganizationID, options)
+        .stopWorkspace(workspaceId, xDaytonaOrganizationID, timeout, options)
         .then((request) => request(axios, basePath))
     },
     /**
@@ -1532,6 +1648,27 @@ export class WorkspaceApi extends BaseAPI {
       .then((request) => request(this.axios, this.basePath))
   }
 
+  /**
+   *
+   * @summary Rebuild workspace
+   * @param {string} workspaceId ID of the workspace
+   * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+   * @param {boolean} [noCache] Rebuild the image without the build cache
+   * @param {*} [options] Override http request option.
+   * @throws {RequiredError}
+   * @memberof WorkspaceApi
+   */
+  public rebuildWorkspace(
+    workspaceId: string,
+    xDaytonaOrganizationID?: string,
+    noCache?: boolean,
+    options?: RawAxiosRequestConfig,
+  ) {
+    return WorkspaceApiFp(this.configuration)
+      .rebuildWorkspace(workspaceId, xDaytonaOrganizationID, noCache, options)
+      .then((request) => request(this.axios, this.basePath))
+  }
+
   /**
    *
    * @summary Replace workspace labels
@@ -1594,13 +1731,19 @@ export class WorkspaceApi extends BaseAPI {
    * @summary Stop workspace
    * @param {string} workspaceId ID of the workspace
    * @param {string} [xDaytonaOrganizationID] Use with JWT to specify the organization ID
+   * @param {number} [timeout] Seconds the workspace gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the workspace. 0 kills it immediately
    * @param {*} [options] Override http request option.
    * @throws {RequiredError}
    * @memberof WorkspaceApi
    */
-  public stopWorkspace(workspaceId: string, xDaytonaOrganizationID?: string, options?: RawAxiosRequestConfig) {
+  public stopWorkspace(
+    workspaceId: string,
+    xDaytonaOrganizationID?: string,
+    timeout?: number,
+    options?: RawAxiosRequestConfig,
+  ) {
     return WorkspaceApiFp(this.configuration)
-      .stopWorkspace(workspaceId, xDaytonaOrganizationID, options)
+      .stopWorkspace(workspaceId, xDaytonaOrganizationID, timeout, options)
       .then((request) => request(this.axios, this.basePath))
   }
 
@@ -30,4 +30,10 @@ export interface CreateBuildInfo {
    * @memberof CreateBuildInfo
    */
   contextHashes?: Array<string>
+  /**
+   * Rebuild the image even if it was built before, without using the build cache
+   * @type {boolean}
+   * @memberof CreateBuildInfo
+   */
+  noCache?: boolean
 }
@@ -17,6 +17,21 @@
 import type { CreateBuildInfo } from './create-build-info'
 // May contain unused imports in some cases
 // @ts-ignore
+import type { ReadinessProbe } from './readiness-probe'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { WorkspaceFile } from './workspace-file'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { WorkspaceInitStep } from './workspace-init-step'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { WorkspaceSecurity } from './workspace-security'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { WorkspaceUlimit } from './workspace-ulimit'
+// May contain unused imports in some cases
+// @ts-ignore
 import type { WorkspaceVolume } from './workspace-volume'
 
 /**
@@ -109,6 +124,126 @@ export interface CreateWorkspace {
    * @memberof CreateWorkspace
    */
   buildInfo?: CreateBuildInfo
+  /**
+   * Create the workspace without starting it, it stays stopped until it is started
+   * @type {boolean}
+   * @memberof CreateWorkspace
+   */
+  noStart?: boolean
+  /**
+   * Entrypoint of the workspace container, overrides the entrypoint of the image
+   * @type {Array<string>}
+   * @memberof CreateWorkspace
+   */
+  entrypoint?: Array<string>
+  /**
+   * Command of the workspace container, overrides the command of the image. The Daytona daemon is started alongside it
+   * @type {Array<string>}
+   * @memberof CreateWorkspace
+   */
+  cmd?: Array<string>
+  /**
+   * Probe that must succeed before the workspace is reported as started. Either port or command must be set
+   * @type {ReadinessProbe}
+   * @memberof CreateWorkspace
+   */
+  readinessProbe?: ReadinessProbe
+  /**
+   * Custom DNS servers of the workspace container
+   * @type {Array<string>}
+   * @memberof CreateWorkspace
+   */
+  dns?: Array<string>
+  /**
+   * Additional host-to-IP mappings of the workspace container (format: HOST:IP)
+   * @type {Array<string>}
+   * @memberof CreateWorkspace
+   */
+  extraHosts?: Array<string>
+  /**
+   * Mount the root filesystem of the workspace read-only. /tmp, /run and the home directory of the user stay writable
+   * @type {boolean}
+   * @memberof CreateWorkspace
+   */
+  readonlyRootfs?: boolean
+  /**
+   * Additional tmpfs mounts of the workspace container (format: PATH[:OPTIONS], e.g. /var/cache:size=64m)
+   * @type {Array<string>}
+   * @memberof CreateWorkspace
+   */
+  tmpfs?: Array<string>
+  /**
+   * Security profiles and capabilities of the workspace container. Setting them makes the workspace unprivileged
+   * @type {WorkspaceSecurity}
+   * @memberof CreateWorkspace
+   */
+  security?: WorkspaceSecurity
+  /**
+   * Run the workspace container privileged, needed for Docker-in-Docker. Defaults to true if the node allows privileged workspaces and no security settings are given
+   * @type {boolean}
+   * @memberof CreateWorkspace
+   */
+  privileged?: boolean
+  /**
+   * Bind mount the Docker socket of the node into the workspace. This gives the workspace full control over the Docker daemon of the node
+   * @type {boolean}
+   * @memberof CreateWorkspace
+   */
+  mountDockerSocket?: boolean
+  /**
+   * Resource limits of the workspace processes. nofile defaults to a soft limit of 65536 and a hard limit of 1048576
+   * @type {Array<WorkspaceUlimit>}
+   * @memberof CreateWorkspace
+   */
+  ulimits?: Array<WorkspaceUlimit>
+  /**
+   * Steps that run to completion in order before the workspace first starts, sharing the volumes of the workspace
+   * @type {Array<WorkspaceInitStep>}
+   * @memberof CreateWorkspace
+   */
+  initSteps?: Array<WorkspaceInitStep>
+  /**
+   * Files written into the workspace before it first starts. With a read-only root filesystem, paths must be on a volume
+   * @type {Array<WorkspaceFile>}
+   * @memberof CreateWorkspace
+   */
+  files?: Array<WorkspaceFile>
+  /**
+   * Hostname of the workspace container, defaults to the workspace ID
+   * @type {string}
+   * @memberof CreateWorkspace
+   */
+  hostname?: string
+  /**
+   * Domain name of the workspace container
+   * @type {string}
+   * @memberof CreateWorkspace
+   */
+  domainname?: string
+  /**
+   * Image pull policy of the workspace and init step images. Defaults to always for images tagged latest and if-not-present otherwise
+   * @type {string}
+   * @memberof CreateWorkspace
+   */
+  imagePullPolicy?: CreateWorkspaceImagePullPolicyEnum
+  /**
+   * Shell commands run in order inside the workspace after it first starts and becomes ready, e.g. to install dependencies
+   * @type {Array<string>}
+   * @memberof CreateWorkspace
+   */
+  postCreateCommands?: Array<string>
+  /**
+   * Keep the workspace when a post-create command fails instead of failing the creation
+   * @type {boolean}
+   * @memberof CreateWorkspace
+   */
+  postCreateContinueOnError?: boolean
+  /**
+   * Seconds the workspace gets to shut down after SIGTERM when it is stopped before it is killed, e.g. to let a database flush. Killed immediately by default
+   * @type {number}
+   * @memberof CreateWorkspace
+   */
+  stopGracePeriodSeconds?: number
 }
 
 export const CreateWorkspaceClassEnum = {
@@ -125,3 +260,11 @@ export const CreateWorkspaceTargetEnum = {
 } as const
 
 export type CreateWorkspaceTargetEnum = (typeof CreateWorkspaceTargetEnum)[keyof typeof CreateWorkspaceTargetEnum]
+export const CreateWorkspaceImagePullPolicyEnum = {
+  ALWAYS: 'always',
+  IF_NOT_PRESENT: 'if-not-present',
+  NEVER: 'never',
+} as const
+
+export type CreateWorkspaceImagePullPolicyEnum =
+  (typeof CreateWorkspaceImagePullPolicyEnum)[keyof typeof CreateWorkspaceImagePullPolicyEnum]
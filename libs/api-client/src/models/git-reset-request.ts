@@ -0,0 +1,58 @@
+/* tslint:disable */
+
+/**
+ * Daytona
+ * Daytona AI platform API Docs
+ *
+ * The version of the OpenAPI document: 1.0
+ * Contact: support@daytona.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface GitResetRequest
+ */
+export interface GitResetRequest {
+  /**
+   *
+   * @type {string}
+   * @memberof GitResetRequest
+   */
+  path: string
+  /**
+   *
+   * @type {string}
+   * @memberof GitResetRequest
+   */
+  username?: string
+  /**
+   *
+   * @type {string}
+   * @memberof GitResetRequest
+   */
+  password?: string
+  /**
+   * hard (default) discards local changes, stash stashes them before resetting
+   * @type {string}
+   * @memberof GitResetRequest
+   */
+  mode?: GitResetRequestModeEnum
+  /**
+   * Required to hard reset a repository with uncommitted changes
+   * @type {boolean}
+   * @memberof GitResetRequest
+   */
+  discard_changes?: boolean
+}
+
+export const GitResetRequestModeEnum = {
+  HARD: 'hard',
+  STASH: 'stash',
+} as const
+
+export type GitResetRequestModeEnum = (typeof GitResetRequestModeEnum)[keyof typeof GitResetRequestModeEnum]
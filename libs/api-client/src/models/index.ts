@@ -33,6 +33,8 @@ export * from './git-commit-request'
 export * from './git-commit-response'
 export * from './git-delete-branch-request'
 export * from './git-repo-request'
+export * from './git-reset-request'
+export * from './git-reset-response'
 export * from './git-status'
 export * from './image-dto'
 export * from './image-state'
@@ -53,6 +55,7 @@ export * from './port-preview-url'
 export * from './position'
 export * from './project-dir-response'
 export * from './range'
+export * from './readiness-probe'
 export * from './registry-push-access-dto'
 export * from './replace-request'
 export * from './replace-result'
@@ -75,7 +78,11 @@ export * from './user-public-key'
 export * from './volume-dto'
 export * from './volume-state'
 export * from './workspace'
+export * from './workspace-file'
 export * from './workspace-info'
+export * from './workspace-init-step'
 export * from './workspace-labels'
+export * from './workspace-security'
 export * from './workspace-state'
+export * from './workspace-ulimit'
 export * from './workspace-volume'
@@ -0,0 +1,51 @@
+/* tslint:disable */
+
+/**
+ * Daytona
+ * Daytona AI platform API Docs
+ *
+ * The version of the OpenAPI document: 1.0
+ * Contact: support@daytona.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface ReadinessProbe
+ */
+export interface ReadinessProbe {
+  /**
+   * TCP port inside the workspace that must accept connections
+   * @type {number}
+   * @memberof ReadinessProbe
+   */
+  port?: number
+  /**
+   * Command run inside the workspace that must exit with code 0
+   * @type {Array<string>}
+   * @memberof ReadinessProbe
+   */
+  command?: Array<string>
+  /**
+   * Number of attempts before the probe fails, defaults to 30
+   * @type {number}
+   * @memberof ReadinessProbe
+   */
+  retries?: number
+  /**
+   * Delay between attempts in seconds, defaults to 1
+   * @type {number}
+   * @memberof ReadinessProbe
+   */
+  intervalSeconds?: number
+  /**
+   * Timeout of a single attempt in seconds, defaults to 1
+   * @type {number}
+   * @memberof ReadinessProbe
+   */
+  timeoutSeconds?: number
+}
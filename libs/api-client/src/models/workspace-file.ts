@@ -0,0 +1,39 @@
+/* tslint:disable */
+
+/**
+ * Daytona
+ * Daytona AI platform API Docs
+ *
+ * The version of the OpenAPI document: 1.0
+ * Contact: support@daytona.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface WorkspaceFile
+ */
+export interface WorkspaceFile {
+  /**
+   * Absolute path of the file inside the workspace
+   * @type {string}
+   * @memberof WorkspaceFile
+   */
+  path: string
+  /**
+   * Base64 encoded content of the file
+   * @type {string}
+   * @memberof WorkspaceFile
+   */
+  content: string
+  /**
+   * Permission bits of the file as a decimal number (e.g. 384 for 0600), defaults to 0644
+   * @type {number}
+   * @memberof WorkspaceFile
+   */
+  mode?: number
+}
@@ -37,4 +37,22 @@ export interface WorkspaceInfo {
    * @memberof WorkspaceInfo
    */
   providerMetadata?: string
+  /**
+   * Registry digest of the image the workspace is running, only included in verbose output
+   * @type {string}
+   * @memberof WorkspaceInfo
+   */
+  imageDigest?: string
+  /**
+   * Number of times the workspace was restarted by its restart policy, only included in verbose output
+   * @type {number}
+   * @memberof WorkspaceInfo
+   */
+  restartCount?: number
+  /**
+   * Durations in seconds of the steps of the last workspace creation, only included in verbose output
+   * @type {{ [key: string]: number; }}
+   * @memberof WorkspaceInfo
+   */
+  phaseDurations?: { [key: string]: number }
 }
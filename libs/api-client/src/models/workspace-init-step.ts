@@ -0,0 +1,39 @@
+/* tslint:disable */
+
+/**
+ * Daytona
+ * Daytona AI platform API Docs
+ *
+ * The version of the OpenAPI document: 1.0
+ * Contact: support@daytona.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface WorkspaceInitStep
+ */
+export interface WorkspaceInitStep {
+  /**
+   * Image of the step
+   * @type {string}
+   * @memberof WorkspaceInitStep
+   */
+  image: string
+  /**
+   * Entrypoint of the step, overrides the entrypoint of the image
+   * @type {Array<string>}
+   * @memberof WorkspaceInitStep
+   */
+  entrypoint?: Array<string>
+  /**
+   * Command of the step, overrides the command of the image
+   * @type {Array<string>}
+   * @memberof WorkspaceInitStep
+   */
+  command?: Array<string>
+}
@@ -0,0 +1,45 @@
+/* tslint:disable */
+
+/**
+ * Daytona
+ * Daytona AI platform API Docs
+ *
+ * The version of the OpenAPI document: 1.0
+ * Contact: support@daytona.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface WorkspaceSecurity
+ */
+export interface WorkspaceSecurity {
+  /**
+   * Path of a seccomp profile on the node, or unconfined
+   * @type {string}
+   * @memberof WorkspaceSecurity
+   */
+  seccompProfile?: string
+  /**
+   * Name of a loaded AppArmor profile, or unconfined
+   * @type {string}
+   * @memberof WorkspaceSecurity
+   */
+  appArmorProfile?: string
+  /**
+   * Linux capabilities to add, e.g. SYS_PTRACE
+   * @type {Array<string>}
+   * @memberof WorkspaceSecurity
+   */
+  capAdd?: Array<string>
+  /**
+   * Linux capabilities to drop, e.g. NET_RAW
+   * @type {Array<string>}
+   * @memberof WorkspaceSecurity
+   */
+  capDrop?: Array<string>
+}
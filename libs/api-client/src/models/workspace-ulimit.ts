@@ -0,0 +1,39 @@
+/* tslint:disable */
+
+/**
+ * Daytona
+ * Daytona AI platform API Docs
+ *
+ * The version of the OpenAPI document: 1.0
+ * Contact: support@daytona.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface WorkspaceUlimit
+ */
+export interface WorkspaceUlimit {
+  /**
+   * Name of the limit, e.g. nofile or nproc
+   * @type {string}
+   * @memberof WorkspaceUlimit
+   */
+  name: string
+  /**
+   * Soft limit
+   * @type {number}
+   * @memberof WorkspaceUlimit
+   */
+  soft: number
+  /**
+   * Hard limit
+   * @type {number}
+   * @memberof WorkspaceUlimit
+   */
+  hard: number
+}
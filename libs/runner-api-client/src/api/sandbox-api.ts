@@ -172,10 +172,11 @@ export const SandboxApiAxiosParamCreator = function (configuration?: Configurati
      * Get sandbox info
      * @summary Get sandbox info
      * @param {string} workspaceId Sandbox ID
+     * @param {boolean} [verbose] Include the container and image details of the sandbox
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    info: async (workspaceId: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+    info: async (workspaceId: string, verbose?: boolean, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
       // verify required parameter 'workspaceId' is not null or undefined
       assertParamExists('info', 'workspaceId', workspaceId)
       const localVarPath = `/workspaces/{workspaceId}`.replace(
@@ -196,6 +197,10 @@ export const SandboxApiAxiosParamCreator = function (configuration?: Configurati
       // authentication Bearer required
       await setApiKeyToObject(localVarHeaderParameter, 'Authorization', configuration)
 
+      if (verbose !== undefined) {
+        localVarQueryParameter['verbose'] = verbose
+      }
+
       setSearchParams(localVarUrlObj, localVarQueryParameter)
       let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {}
       localVarRequestOptions.headers = { ...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers }
@@ -330,10 +335,11 @@ export const SandboxApiAxiosParamCreator = function (configuration?: Configurati
      * Stop sandbox
      * @summary Stop sandbox
      * @param {string} workspaceId Sandbox ID
+     * @param {number} [timeout] Seconds the sandbox gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the sandbox. 0 kills it immediately
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    stop: async (workspaceId: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+    stop: async (workspaceId: string, timeout?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
       // verify required parameter 'workspaceId' is not null or undefined
       assertParamExists('stop', 'workspaceId', workspaceId)
       const localVarPath = `/workspaces/{workspaceId}/stop`.replace(
@@ -354,6 +360,10 @@ export const SandboxApiAxiosParamCreator = function (configuration?: Configurati
       // authentication Bearer required
       await setApiKeyToObject(localVarHeaderParameter, 'Authorization', configuration)
 
+      if (timeout !== undefined) {
+        localVarQueryParameter['timeout'] = timeout
+      }
+
       setSearchParams(localVarUrlObj, localVarQueryParameter)
       let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {}
       localVarRequestOptions.headers = { ...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers }
@@ -448,14 +458,16 @@ export const SandboxApiFp = function (configuration?: Configuration) {
      * Get sandbox info
      * @summary Get sandbox info
      * @param {string} workspaceId Sandbox ID
+     * @param {boolean} [verbose] Include the container and image details of the sandbox
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
     async info(
       workspaceId: string,
+      verbose?: boolean,
       options?: RawAxiosRequestConfig,
     ): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<SandboxInfoResponse>> {
-      const localVarAxiosArgs = await localVarAxiosParamCreator.info(workspaceId, options)
+      const localVarAxiosArgs = await localVarAxiosParamCreator.info(workspaceId, verbose, options)
       const localVarOperationServerIndex = configuration?.serverIndex ?? 0
       const localVarOperationServerBasePath = operationServerMap['SandboxApi.info']?.[localVarOperationServerIndex]?.url
       return (axios, basePath) =>
@@ -541,14 +553,16 @@ export const SandboxApiFp = function (configuration?: Configuration) {
      * Stop sandbox
      * @summary Stop sandbox
      * @param {string} workspaceId Sandbox ID
+     * @param {number} [timeout] Seconds the sandbox gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the sandbox. 0 kills it immediately
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
     async stop(
       workspaceId: string,
+      timeout?: number,
       options?: RawAxiosRequestConfig,
     ): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
-      const localVarAxiosArgs = await localVarAxiosParamCreator.stop(workspaceId, options)
+      const localVarAxiosArgs = await localVarAxiosParamCreator.stop(workspaceId, timeout, options)
       const localVarOperationServerIndex = configuration?.serverIndex ?? 0
       const localVarOperationServerBasePath = operationServerMap['SandboxApi.stop']?.[localVarOperationServerIndex]?.url
       return (axios, basePath) =>
@@ -608,11 +622,12 @@ export const SandboxApiFactory = function (configuration?: Configuration, basePa
      * Get sandbox info
      * @summary Get sandbox info
      * @param {string} workspaceId Sandbox ID
+     * @param {boolean} [verbose] Include the container and image details of the sandbox
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    info(workspaceId: string, options?: RawAxiosRequestConfig): AxiosPromise<SandboxInfoResponse> {
-      return localVarFp.info(workspaceId, options).then((request) => request(axios, basePath))
+    info(workspaceId: string, verbose?: boolean, options?: RawAxiosRequestConfig): AxiosPromise<SandboxInfoResponse> {
+      return localVarFp.info(workspaceId, verbose, options).then((request) => request(axios, basePath))
     },
     /**
      * Remove a sandbox that has been previously destroyed
@@ -649,11 +664,12 @@ export const SandboxApiFactory = function (configuration?: Configuration, basePa
      * Stop sandbox
      * @summary Stop sandbox
      * @param {string} workspaceId Sandbox ID
+     * @param {number} [timeout] Seconds the sandbox gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the sandbox. 0 kills it immediately
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    stop(workspaceId: string, options?: RawAxiosRequestConfig): AxiosPromise<string> {
-      return localVarFp.stop(workspaceId, options).then((request) => request(axios, basePath))
+    stop(workspaceId: string, timeout?: number, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+      return localVarFp.stop(workspaceId, timeout, options).then((request) => request(axios, basePath))
     },
   }
 }
@@ -712,13 +728,14 @@ export class SandboxApi extends BaseAPI {
    * Get sandbox info
    * @summary Get sandbox info
    * @param {string} workspaceId Sandbox ID
+   * @param {boolean} [verbose] Include the container and image details of the sandbox
    * @param {*} [options] Override http request option.
    * @throws {RequiredError}
    * @memberof SandboxApi
    */
-  public info(workspaceId: string, options?: RawAxiosRequestConfig) {
+  public info(workspaceId: string, verbose?: boolean, options?: RawAxiosRequestConfig) {
     return SandboxApiFp(this.configuration)
-      .info(workspaceId, options)
+      .info(workspaceId, verbose, options)
       .then((request) => request(this.axios, this.basePath))
   }
 
@@ -769,13 +786,14 @@ export class SandboxApi extends BaseAPI {
    * Stop sandbox
    * @summary Stop sandbox
    * @param {string} workspaceId Sandbox ID
+   * @param {number} [timeout] Seconds the sandbox gets to shut down after SIGTERM before it is killed, overrides the stop grace period of the sandbox. 0 kills it immediately
    * @param {*} [options] Override http request option.
    * @throws {RequiredError}
    * @memberof SandboxApi
    */
-  public stop(workspaceId: string, options?: RawAxiosRequestConfig) {
+  public stop(workspaceId: string, timeout?: number, options?: RawAxiosRequestConfig) {
     return SandboxApiFp(this.configuration)
-      .stop(workspaceId, options)
+      .stop(workspaceId, timeout, options)
       .then((request) => request(this.axios, this.basePath))
   }
 }
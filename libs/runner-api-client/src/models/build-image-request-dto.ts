@@ -40,6 +40,12 @@ export interface BuildImageRequestDTO {
    * @memberof BuildImageRequestDTO
    */
   image?: string
+  /**
+   * Rebuild even if the image exists and skip the build cache
+   * @type {boolean}
+   * @memberof BuildImageRequestDTO
+   */
+  noCache?: boolean
   /**
    *
    * @type {string}
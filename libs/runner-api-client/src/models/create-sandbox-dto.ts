@@ -17,7 +17,22 @@
 import type { DtoVolumeDTO } from './dto-volume-dto'
 // May contain unused imports in some cases
 // @ts-ignore
+import type { FileDTO } from './file-dto'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { InitStepDTO } from './init-step-dto'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { ReadinessProbeDTO } from './readiness-probe-dto'
+// May contain unused imports in some cases
+// @ts-ignore
 import type { RegistryDTO } from './registry-dto'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { SecurityDTO } from './security-dto'
+// May contain unused imports in some cases
+// @ts-ignore
+import type { UlimitDTO } from './ulimit-dto'
 
 /**
  *
@@ -25,12 +40,30 @@ import type { RegistryDTO } from './registry-dto'
  * @interface CreateSandboxDTO
  */
 export interface CreateSandboxDTO {
+  /**
+   *
+   * @type {Array<string>}
+   * @memberof CreateSandboxDTO
+   */
+  cmd?: Array<string>
   /**
    *
    * @type {number}
    * @memberof CreateSandboxDTO
    */
   cpuQuota?: number
+  /**
+   * Custom DNS servers
+   * @type {Array<string>}
+   * @memberof CreateSandboxDTO
+   */
+  dns?: Array<string>
+  /**
+   * Domain name of the sandbox container
+   * @type {string}
+   * @memberof CreateSandboxDTO
+   */
+  domainname?: string
   /**
    *
    * @type {Array<string>}
@@ -43,6 +76,18 @@ export interface CreateSandboxDTO {
    * @memberof CreateSandboxDTO
    */
   env?: { [key: string]: string }
+  /**
+   * Additional host-to-IP mappings (format: HOST:IP)
+   * @type {Array<string>}
+   * @memberof CreateSandboxDTO
+   */
+  extraHosts?: Array<string>
+  /**
+   * Files written into the sandbox before it starts. With a read-only root filesystem, paths must be on a volume
+   * @type {Array<FileDTO>}
+   * @memberof CreateSandboxDTO
+   */
+  files?: Array<FileDTO>
   /**
    *
    * @type {string}
@@ -55,6 +100,12 @@ export interface CreateSandboxDTO {
    * @memberof CreateSandboxDTO
    */
   gpuQuota?: number
+  /**
+   * Hostname of the sandbox container, defaults to the sandbox ID
+   * @type {string}
+   * @memberof CreateSandboxDTO
+   */
+  hostname?: string
   /**
    *
    * @type {string}
@@ -67,30 +118,114 @@ export interface CreateSandboxDTO {
    * @memberof CreateSandboxDTO
    */
   image: string
+  /**
+   * Image pull policy for the sandbox and init step images (always, if-not-present, never). Defaults to always for images tagged latest and if-not-present otherwise
+   * @type {string}
+   * @memberof CreateSandboxDTO
+   */
+  imagePullPolicy?: string
+  /**
+   * Steps that run to completion in order before the sandbox starts, sharing the volumes of the sandbox
+   * @type {Array<InitStepDTO>}
+   * @memberof CreateSandboxDTO
+   */
+  initSteps?: Array<InitStepDTO>
   /**
    *
    * @type {number}
    * @memberof CreateSandboxDTO
    */
   memoryQuota?: number
+  /**
+   * Bind mount the Docker socket of the runner host into the sandbox. This gives the sandbox full control over the host's Docker daemon
+   * @type {boolean}
+   * @memberof CreateSandboxDTO
+   */
+  mountDockerSocket?: boolean
+  /**
+   * Pull the image and create the container without starting it
+   * @type {boolean}
+   * @memberof CreateSandboxDTO
+   */
+  noStart?: boolean
   /**
    *
    * @type {string}
    * @memberof CreateSandboxDTO
    */
   osUser: string
+  /**
+   * Shell commands run in order inside the sandbox after it first starts and becomes ready, e.g. to install dependencies. Their output is written to the runner logs
+   * @type {Array<string>}
+   * @memberof CreateSandboxDTO
+   */
+  postCreateCommands?: Array<string>
+  /**
+   * Keep the sandbox when a post-create command fails instead of failing the creation
+   * @type {boolean}
+   * @memberof CreateSandboxDTO
+   */
+  postCreateContinueOnError?: boolean
+  /**
+   * Run the sandbox container privileged, needed for Docker-in-Docker. Defaults to true if the runner allows privileged sandboxes and no security settings are given
+   * @type {boolean}
+   * @memberof CreateSandboxDTO
+   */
+  privileged?: boolean
+  /**
+   *
+   * @type {ReadinessProbeDTO}
+   * @memberof CreateSandboxDTO
+   */
+  readinessProbe?: ReadinessProbeDTO
+  /**
+   * Mount the root filesystem of the sandbox read-only. /tmp, /run and the home directory of the user stay writable
+   * @type {boolean}
+   * @memberof CreateSandboxDTO
+   */
+  readonlyRootfs?: boolean
   /**
    *
    * @type {RegistryDTO}
    * @memberof CreateSandboxDTO
    */
   registry?: RegistryDTO
+  /**
+   * Restart policy of the sandbox container (no, always, unless-stopped, on-failure[:max-retries]), defaults to no
+   * @type {string}
+   * @memberof CreateSandboxDTO
+   */
+  restartPolicy?: string
+  /**
+   * Security profiles and capabilities of the sandbox container, Docker defaults are used when unset. Setting them makes the sandbox unprivileged, they can't be combined with privileged set to true
+   * @type {SecurityDTO}
+   * @memberof CreateSandboxDTO
+   */
+  security?: SecurityDTO
+  /**
+   * Seconds the sandbox gets to shut down cleanly after SIGTERM before it is killed on stop. By default the sandbox is killed immediately
+   * @type {number}
+   * @memberof CreateSandboxDTO
+   */
+  stopGracePeriodSeconds?: number
   /**
    *
    * @type {number}
    * @memberof CreateSandboxDTO
    */
   storageQuota?: number
+  /**
+   * Additional tmpfs mounts (format: PATH[:OPTIONS], e.g. /var/cache:size=64m)
+   * @type {Array<string>}
+   * @memberof CreateSandboxDTO
+   */
+  tmpfs?: Array<string>
+  /**
+   * Resource limits of the sandbox processes. nofile defaults to a soft limit of 65536 and a hard limit of 1048576
+   * @type {Array<UlimitDTO>}
+   * @memberof CreateSandboxDTO
+   */
+  ulimits?: Array<UlimitDTO>
   /**
    *
    * @type {string}
@@ -0,0 +1,45 @@
+/* tslint:disable */
+
+/**
+ * Daytona Runner API
+ * Daytona Runner API
+ *
+ * The version of the OpenAPI document: v0.0.0-dev
+ *
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface FileDTO
+ */
+export interface FileDTO {
+  /**
+   * Base64 encoded content of the file
+   * @type {string}
+   * @memberof FileDTO
+   */
+  content?: string
+  /**
+   * Permission bits of the file as a decimal number (e.g. 384 for 0600), defaults to 0644
+   * @type {number}
+   * @memberof FileDTO
+   */
+  mode?: number
+  /**
+   * Absolute path of the file inside the sandbox
+   * @type {string}
+   * @memberof FileDTO
+   */
+  path: string
+  /**
+   * Path of the file to copy in the files source directory of the runner (FILES_SOURCE_DIR), e.g. a mounted secrets store, used instead of content. Sources are read when the sandbox is created and never logged
+   * @type {string}
+   * @memberof FileDTO
+   */
+  sourcePath?: string
+}
@@ -5,8 +5,13 @@ export * from './dto-volume-dto'
 export * from './enums-sandbox-state'
 export * from './enums-snapshot-state'
 export * from './error-response'
+export * from './file-dto'
 export * from './image-exists-response'
+export * from './init-step-dto'
 export * from './pull-image-request-dto'
+export * from './readiness-probe-dto'
 export * from './registry-dto'
 export * from './resize-sandbox-dto'
 export * from './sandbox-info-response'
+export * from './security-dto'
+export * from './ulimit-dto'
@@ -0,0 +1,39 @@
+/* tslint:disable */
+
+/**
+ * Daytona Runner API
+ * Daytona Runner API
+ *
+ * The version of the OpenAPI document: v0.0.0-dev
+ *
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface InitStepDTO
+ */
+export interface InitStepDTO {
+  /**
+   *
+   * @type {Array<string>}
+   * @memberof InitStepDTO
+   */
+  command?: Array<string>
+  /**
+   *
+   * @type {Array<string>}
+   * @memberof InitStepDTO
+   */
+  entrypoint?: Array<string>
+  /**
+   *
+   * @type {string}
+   * @memberof InitStepDTO
+   */
+  image: string
+}
@@ -0,0 +1,51 @@
+/* tslint:disable */
+
+/**
+ * Daytona Runner API
+ * Daytona Runner API
+ *
+ * The version of the OpenAPI document: v0.0.0-dev
+ *
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface ReadinessProbeDTO
+ */
+export interface ReadinessProbeDTO {
+  /**
+   * Command run inside the sandbox that must exit with code 0
+   * @type {Array<string>}
+   * @memberof ReadinessProbeDTO
+   */
+  command?: Array<string>
+  /**
+   * Delay between attempts in seconds
+   * @type {number}
+   * @memberof ReadinessProbeDTO
+   */
+  intervalSeconds?: number
+  /**
+   * TCP port inside the sandbox that must accept connections
+   * @type {number}
+   * @memberof ReadinessProbeDTO
+   */
+  port?: number
+  /**
+   * Number of attempts before the probe is considered failed
+   * @type {number}
+   * @memberof ReadinessProbeDTO
+   */
+  retries?: number
+  /**
+   * Timeout of a single attempt in seconds
+   * @type {number}
+   * @memberof ReadinessProbeDTO
+   */
+  timeoutSeconds?: number
+}
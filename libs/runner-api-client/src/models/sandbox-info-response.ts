@@ -25,6 +25,36 @@ import type { EnumsSnapshotState } from './enums-snapshot-state'
  * @interface SandboxInfoResponse
  */
 export interface SandboxInfoResponse {
+  /**
+   * Image reference the sandbox container is running
+   * @type {string}
+   * @memberof SandboxInfoResponse
+   */
+  image?: string
+  /**
+   * Registry digest of the running image, empty for locally built images
+   * @type {string}
+   * @memberof SandboxInfoResponse
+   */
+  imageDigest?: string
+  /**
+   * Local ID of the image the sandbox container is running
+   * @type {string}
+   * @memberof SandboxInfoResponse
+   */
+  imageId?: string
+  /**
+   * Durations in seconds of the steps of the last sandbox creation
+   * @type {{ [key: string]: number; }}
+   * @memberof SandboxInfoResponse
+   */
+  phaseDurations?: { [key: string]: number }
+  /**
+   * Number of times the sandbox container was restarted by its restart policy
+   * @type {number}
+   * @memberof SandboxInfoResponse
+   */
+  restartCount?: number
   /**
    *
    * @type {EnumsSnapshotState}
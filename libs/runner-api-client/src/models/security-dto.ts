@@ -0,0 +1,45 @@
+/* tslint:disable */
+
+/**
+ * Daytona Runner API
+ * Daytona Runner API
+ *
+ * The version of the OpenAPI document: v0.0.0-dev
+ *
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface SecurityDTO
+ */
+export interface SecurityDTO {
+  /**
+   * Name of a loaded AppArmor profile, or \"unconfined\"
+   * @type {string}
+   * @memberof SecurityDTO
+   */
+  appArmorProfile?: string
+  /**
+   * Linux capabilities to add, e.g. SYS_PTRACE
+   * @type {Array<string>}
+   * @memberof SecurityDTO
+   */
+  capAdd?: Array<string>
+  /**
+   * Linux capabilities to drop, e.g. NET_RAW
+   * @type {Array<string>}
+   * @memberof SecurityDTO
+   */
+  capDrop?: Array<string>
+  /**
+   * Path of a seccomp profile on the runner host, or \"unconfined\"
+   * @type {string}
+   * @memberof SecurityDTO
+   */
+  seccompProfile?: string
+}
@@ -0,0 +1,39 @@
+/* tslint:disable */
+
+/**
+ * Daytona Runner API
+ * Daytona Runner API
+ *
+ * The version of the OpenAPI document: v0.0.0-dev
+ *
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+/**
+ *
+ * @export
+ * @interface UlimitDTO
+ */
+export interface UlimitDTO {
+  /**
+   *
+   * @type {number}
+   * @memberof UlimitDTO
+   */
+  hard?: number
+  /**
+   * Name of the limit, e.g. nofile or nproc
+   * @type {string}
+   * @memberof UlimitDTO
+   */
+  name: string
+  /**
+   *
+   * @type {number}
+   * @memberof UlimitDTO
+   */
+  soft?: number
+}